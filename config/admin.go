@@ -0,0 +1,18 @@
+package config
+
+import "os"
+
+type AdminConfig struct {
+	Name     string
+	Username string
+	Password string
+}
+
+// first admin account, read on call so the values from .env are already loaded
+func GetAdminConfig() AdminConfig {
+	return AdminConfig{
+		Name:     os.Getenv("ADMIN_NAME"),
+		Username: os.Getenv("ADMIN_USERNAME"),
+		Password: os.Getenv("ADMIN_PASSWORD"),
+	}
+}
@@ -0,0 +1,11 @@
+package config
+
+import "os"
+
+// key to sign pagination cursor, default to jwt key, read on call so the values from .env are already loaded
+func GetCursorSigningKey() []byte {
+	if key := os.Getenv("CURSOR_SIGNING_KEY"); key != "" {
+		return []byte(key)
+	}
+	return []byte(os.Getenv("JWT_KEY"))
+}
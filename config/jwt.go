@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
@@ -10,8 +11,40 @@ var jwtKey = os.Getenv("JWT_KEY")
 
 var JWT_KEY = []byte(jwtKey)
 
+const (
+	TokenTypeAccess     = "access"
+	TokenTypeMFAPending = "mfa_pending"
+	TokenTypeAPIKey     = "api_key"
+)
+
 type JWTClaim struct {
 	Id       int64
 	Username string
+	Role     string
+	// access token for api, mfa_pending only for second login step, api_key never signed as jwt
+	TokenType string
+	// only filled for api key
+	Scopes []string `json:",omitempty"`
 	jwt.RegisteredClaims
 }
+
+type TokenConfig struct {
+	AccessTokenDuration  time.Duration
+	RefreshTokenDuration time.Duration
+}
+
+// token lifetime, read on call so the values from .env are already loaded
+func GetTokenConfig() TokenConfig {
+	return TokenConfig{
+		AccessTokenDuration:  getDurationEnv("ACCESS_TOKEN_DURATION", 15*time.Minute),
+		RefreshTokenDuration: getDurationEnv("REFRESH_TOKEN_DURATION", 7*24*time.Hour),
+	}
+}
+
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
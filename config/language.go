@@ -0,0 +1,11 @@
+package config
+
+import "os"
+
+// last language of public fallback chain, matched against language code
+func GetDefaultLanguageCode() string {
+	if code := os.Getenv("DEFAULT_LANGUAGE_CODE"); code != "" {
+		return code
+	}
+	return "en"
+}
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+type LoginThrottleConfig struct {
+	// failed attempts allowed before backoff start
+	FreeAttempts int
+	// failed attempts before temporary lockout
+	UsernameMaxAttempts int
+	IPMaxAttempts       int
+	// first backoff delay, doubled for every next failed attempt
+	BaseDelay       time.Duration
+	LockoutDuration time.Duration
+	// failed counter start again when there is no failed attempt in this window
+	AttemptWindow time.Duration
+	// read client ip from X-Forwarded-For, only enable behind trusted proxy
+	TrustProxy bool
+}
+
+// login throttle, read on call so the values from .env are already loaded
+func GetLoginThrottleConfig() LoginThrottleConfig {
+	trustProxy, _ := strconv.ParseBool(os.Getenv("LOGIN_TRUST_PROXY"))
+
+	return LoginThrottleConfig{
+		FreeAttempts:        getIntEnv("LOGIN_FREE_ATTEMPTS", 3),
+		UsernameMaxAttempts: getIntEnv("LOGIN_USERNAME_MAX_ATTEMPTS", 5),
+		IPMaxAttempts:       getIntEnv("LOGIN_IP_MAX_ATTEMPTS", 20),
+		BaseDelay:           getDurationEnv("LOGIN_BASE_DELAY", time.Second),
+		LockoutDuration:     getDurationEnv("LOGIN_LOCKOUT_DURATION", 15*time.Minute),
+		AttemptWindow:       getDurationEnv("LOGIN_ATTEMPT_WINDOW", 15*time.Minute),
+		TrustProxy:          trustProxy,
+	}
+}
@@ -0,0 +1,27 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+type MFAConfig struct {
+	// issuer shown on authenticator app
+	Issuer               string
+	PendingTokenDuration time.Duration
+	RecoveryCodeCount    int
+}
+
+// two factor authentication, read on call so the values from .env are already loaded
+func GetMFAConfig() MFAConfig {
+	issuer := os.Getenv("MFA_ISSUER")
+	if issuer == "" {
+		issuer = "Personal Portfolio"
+	}
+
+	return MFAConfig{
+		Issuer:               issuer,
+		PendingTokenDuration: getDurationEnv("MFA_PENDING_TOKEN_DURATION", 5*time.Minute),
+		RecoveryCodeCount:    getIntEnv("MFA_RECOVERY_CODE_COUNT", 10),
+	}
+}
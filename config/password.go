@@ -0,0 +1,51 @@
+package config
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+type PasswordPolicyConfig struct {
+	MinLength int
+	MaxLength int
+	// empty path mean using breached list bundled in binary
+	BreachedListPath string
+}
+
+type PasswordResetConfig struct {
+	TokenDuration time.Duration
+	// log or file
+	Notifier     string
+	NotifierFile string
+}
+
+// password policy, read on call so the values from .env are already loaded
+func GetPasswordPolicyConfig() PasswordPolicyConfig {
+	return PasswordPolicyConfig{
+		MinLength:        getIntEnv("PASSWORD_MIN_LENGTH", 8),
+		MaxLength:        getIntEnv("PASSWORD_MAX_LENGTH", 72), // bcrypt only use first 72 bytes
+		BreachedListPath: os.Getenv("PASSWORD_BREACHED_LIST"),
+	}
+}
+
+func GetPasswordResetConfig() PasswordResetConfig {
+	notifierFile := os.Getenv("PASSWORD_RESET_NOTIFIER_FILE")
+	if notifierFile == "" {
+		notifierFile = "password_reset.log"
+	}
+
+	return PasswordResetConfig{
+		TokenDuration: getDurationEnv("PASSWORD_RESET_TOKEN_DURATION", 30*time.Minute),
+		Notifier:      os.Getenv("PASSWORD_RESET_NOTIFIER"),
+		NotifierFile:  notifierFile,
+	}
+}
+
+func getIntEnv(key string, defaultValue int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return defaultValue
+	}
+	return value
+}
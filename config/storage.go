@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+type StorageConfig struct {
+	// local or s3
+	Driver string
+	// local disk, directory is served under /assets/
+	LocalDirectory string
+	// public base url of stored file, e.g. https://api.example.com/assets or cdn url
+	PublicURL string
+
+	// s3 compatible, e.g. aws s3, minio, r2
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	Timeout     time.Duration
+
+	// orphan younger than this may belong to a row which is still being inserted, it is never deleted
+	OrphanMinAge time.Duration
+}
+
+// file storage backend, read on call so the values from .env are already loaded
+func GetStorageConfig() StorageConfig {
+	localDirectory := os.Getenv("STORAGE_LOCAL_DIRECTORY")
+	if localDirectory == "" {
+		localDirectory = "./assets"
+	}
+
+	region := os.Getenv("S3_REGION")
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return StorageConfig{
+		Driver:         os.Getenv("STORAGE_DRIVER"),
+		LocalDirectory: localDirectory,
+		PublicURL:      os.Getenv("STORAGE_PUBLIC_URL"),
+		S3Endpoint:     os.Getenv("S3_ENDPOINT"),
+		S3Region:       region,
+		S3Bucket:       os.Getenv("S3_BUCKET"),
+		S3AccessKey:    os.Getenv("S3_ACCESS_KEY"),
+		S3SecretKey:    os.Getenv("S3_SECRET_KEY"),
+		Timeout:        getDurationEnv("S3_TIMEOUT", 30*time.Second),
+		OrphanMinAge:   getDurationEnv("STORAGE_ORPHAN_MIN_AGE", time.Hour),
+	}
+}
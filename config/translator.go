@@ -0,0 +1,24 @@
+package config
+
+import (
+	"os"
+	"time"
+)
+
+type TranslatorConfig struct {
+	// none or libretranslate
+	Provider string
+	URL      string
+	APIKey   string
+	Timeout  time.Duration
+}
+
+// machine translation provider, read on call so the values from .env are already loaded
+func GetTranslatorConfig() TranslatorConfig {
+	return TranslatorConfig{
+		Provider: os.Getenv("MACHINE_TRANSLATOR"),
+		URL:      os.Getenv("LIBRETRANSLATE_URL"),
+		APIKey:   os.Getenv("LIBRETRANSLATE_API_KEY"),
+		Timeout:  getDurationEnv("MACHINE_TRANSLATOR_TIMEOUT", 10*time.Second),
+	}
+}
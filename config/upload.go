@@ -0,0 +1,18 @@
+package config
+
+type UploadConfig struct {
+	// max size in bytes by sniffed content type, type not listed is rejected
+	MaxImageSizes map[string]int64
+}
+
+// allowed upload type and size, read on call so the values from .env are already loaded
+func GetUploadConfig() UploadConfig {
+	return UploadConfig{
+		MaxImageSizes: map[string]int64{
+			"image/jpeg": int64(getIntEnv("UPLOAD_MAX_JPEG_SIZE", 5<<20)),
+			"image/png":  int64(getIntEnv("UPLOAD_MAX_PNG_SIZE", 5<<20)),
+			"image/webp": int64(getIntEnv("UPLOAD_MAX_WEBP_SIZE", 5<<20)),
+			"image/gif":  int64(getIntEnv("UPLOAD_MAX_GIF_SIZE", 2<<20)),
+		},
+	}
+}
@@ -2,7 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
+	"errors"
 	"net/http"
 	"time"
 
@@ -11,54 +11,105 @@ import (
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
 	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
 )
 
 // login godoc
 // @Summary login user
-// @Description login user with the provided details
+// @Description login user with the provided details, when two factor authentication enabled mfa_token is returned to be exchanged on /login/mfa
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Param input body models.UserLoginForm true "User input"
-// @Success 200 {object} map[string]string "Success"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 429 {object} helper.Response "Too Many Requests"
 // @Router /login [post]
 func Login(w http.ResponseWriter, r *http.Request) {
 	// define input from json
 	var userInput models.User
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userInput); err != nil {
-		response := map[string]string{"message": err.Error()}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, err.Error())
 		return
 	}
 	defer r.Body.Close()
 
+	// brute force protection per username and per ip
+	throttle := config.GetLoginThrottleConfig()
+	clientIP := helper.GetClientIP(r, throttle.TrustProxy)
+	usernameKey := loginUsernameKey(userInput.Username)
+	if !checkLoginThrottle(w, usernameKey, clientIP) {
+		return
+	}
+
 	// validation
-	var user models.User
 	userRepo := repositories.NewUserRepository()
 	exist_user, err := userRepo.ReadByUsername(userInput.Username)
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Username or password invalid"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		registerLoginFailure(usernameKey, clientIP, throttle)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Username or password invalid")
 		return
 	}
 
 	// check password valid or not
 	if err := userRepo.CompareUserPassword(exist_user.Password, userInput.Password); err != nil {
-		response := map[string]string{"message": "Username or password invalid"}
-		helper.ResponseJSON(w, http.StatusUnauthorized, response)
+		registerLoginFailure(usernameKey, clientIP, throttle)
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Username or password invalid")
+		return
+	}
+
+	// second login step when two factor authentication enabled
+	userMFARepo := repositories.NewUserMFARepository()
+	if userMFA, err := userMFARepo.ReadByUserID(exist_user.ID); err == nil && userMFA.IsEnabled {
+		response, err := issueMFAPendingToken(exist_user)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+			return
+		}
+		helper.ResponseJSON(w, http.StatusOK, *response)
 		return
 	}
+	registerLoginSuccess(usernameKey, clientIP)
 
-	// create token jwt (expired 24 hour)
-	expTime := time.Now().Add(24 * time.Hour)
+	// new login start new refresh token family
+	familyID, err := helper.GenerateRandomToken(16)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	response, err := issueToken(w, exist_user, familyID, nil)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+	helper.ResponseJSON(w, http.StatusOK, *response)
+}
+
+// create short lived access token and refresh token, old refresh token is rotated when provided
+func issueToken(w http.ResponseWriter, user *models.User, familyID string, oldRefreshToken *models.UserRefreshToken) (*helper.Response, error) {
+	tokenConfig := config.GetTokenConfig()
+	now := time.Now()
+	expTime := now.Add(tokenConfig.AccessTokenDuration)
+	refreshExpTime := now.Add(tokenConfig.RefreshTokenDuration)
+
+	jti, err := helper.GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	// create token jwt
 	claims := &config.JWTClaim{
-		Id:       exist_user.ID,
-		Username: user.Username,
+		Id:        user.ID,
+		Username:  user.Username,
+		Role:      user.Role,
+		TokenType: config.TokenTypeAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
 			Issuer:    "go-jwt-mux",
+			IssuedAt:  jwt.NewNumericDate(now),
 			ExpiresAt: jwt.NewNumericDate(expTime),
 		},
 	}
@@ -68,25 +119,138 @@ func Login(w http.ResponseWriter, r *http.Request) {
 	// signed token
 	token, err := tokenAlgo.SignedString(config.JWT_KEY)
 	if err != nil {
-		response := map[string]string{"message": err.Error()}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
-		return
+		return nil, err
+	}
+
+	refreshToken, err := helper.GenerateRandomToken(32)
+	if err != nil {
+		return nil, err
 	}
 
-	// set token yang ke cookie
+	newRefreshToken := models.UserRefreshToken{
+		UserID:               uint(user.ID),
+		FamilyID:             familyID,
+		TokenHash:            helper.HashToken(refreshToken),
+		AccessTokenID:        jti,
+		AccessTokenExpiresAt: expTime,
+		ExpiresAt:            refreshExpTime,
+	}
+
+	refreshTokenRepo := repositories.NewUserRefreshTokenRepository()
+	if oldRefreshToken != nil {
+		_, err = refreshTokenRepo.Rotate(oldRefreshToken, &newRefreshToken)
+	} else {
+		_, err = refreshTokenRepo.Create(&newRefreshToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// set token to cookie
 	http.SetCookie(w, &http.Cookie{
 		Name:     "token",
 		Path:     "/",
 		Value:    token,
+		Expires:  expTime,
+		HttpOnly: true,
+	})
+	http.SetCookie(w, &http.Cookie{
+		Name:     "refresh_token",
+		Path:     "/",
+		Value:    refreshToken,
+		Expires:  refreshExpTime,
 		HttpOnly: true,
 	})
 
-	response := map[string]string{
-		"message":      "success",
-		"access_token": token,
-		"expired":      expTime.String(),
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]string{
+			"token_type":      "Bearer",
+			"access_token":    token,
+			"expired":         expTime.String(),
+			"refresh_token":   refreshToken,
+			"refresh_expired": refreshExpTime.String(),
+		},
 	}
-	helper.ResponseJSON(w, http.StatusOK, response)
+	return &response, nil
+}
+
+// take refresh token from json body, fallback to refresh_token cookie
+func getRefreshTokenString(r *http.Request) string {
+	var refreshTokenInput models.RefreshTokenForm
+	if r.Body != nil {
+		// empty body is allowed when refresh token sent by cookie
+		_ = json.NewDecoder(r.Body).Decode(&refreshTokenInput)
+		defer r.Body.Close()
+	}
+	if refreshTokenInput.RefreshToken != "" {
+		return refreshTokenInput.RefreshToken
+	}
+
+	c, err := r.Cookie("refresh_token")
+	if err != nil {
+		return ""
+	}
+	return c.Value
+}
+
+// Refresh godoc
+// @Summary Refresh access token
+// @Description Exchange refresh token with new access token and refresh token, refresh token can also be sent by refresh_token cookie
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.RefreshTokenForm false "Refresh token input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Router /refresh [post]
+func Refresh(w http.ResponseWriter, r *http.Request) {
+	refreshToken := getRefreshTokenString(r)
+	if refreshToken == "" {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Refresh token is required")
+		return
+	}
+
+	refreshTokenRepo := repositories.NewUserRefreshTokenRepository()
+	existRefreshToken, err := refreshTokenRepo.ReadByTokenHash(helper.HashToken(refreshToken))
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Refresh token invalid")
+		return
+	}
+
+	// reused refresh token mean it was stolen, revoke the whole family
+	if existRefreshToken.RevokedAt != nil {
+		if err := refreshTokenRepo.RevokeByFamilyID(existRefreshToken.FamilyID); err != nil {
+			helper.ResponseDatabaseError(w, err, err.Error())
+			return
+		}
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Refresh token has been revoked")
+		return
+	}
+
+	if existRefreshToken.ExpiresAt.Before(time.Now()) {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Refresh token is expired")
+		return
+	}
+
+	// read user again so changed role or username is applied
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.Read(int64(existRefreshToken.UserID))
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Refresh token invalid")
+		return
+	}
+
+	response, err := issueToken(w, user, existRefreshToken.FamilyID, existRefreshToken)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Refresh token has been revoked")
+			return
+		}
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+	helper.ResponseJSON(w, http.StatusOK, *response)
 }
 
 // Register godoc
@@ -96,38 +260,48 @@ func Login(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param input body models.UserCreateForm true "User input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /register [post]
 func Register(w http.ResponseWriter, r *http.Request) {
 	// define input from json
 	var userInput models.UserCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userInput); err != nil {
-		log.Fatal("Error decoding new user: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	if !validateNewPassword(w, userInput.Password) {
+		return
+	}
+
 	// validate username unique
 	userRepo := repositories.NewUserRepository()
 	exist_user, _ := userRepo.ReadByUsername(userInput.Username)
 	if exist_user != nil {
 		// Handle error
-		response := map[string]string{"message": "Username already used"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Username already used")
 		return
 	}
 
 	// insert to database
 	if newUser, err := userRepo.Create(&userInput); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUser.ID,
 			},
 		}
@@ -138,13 +312,39 @@ func Register(w http.ResponseWriter, r *http.Request) {
 
 // Logout godoc
 // @Summary Logout user
-// @Description Logout user
+// @Description Logout user and revoke refresh token family, refresh token can be sent by json body or refresh_token cookie
 // @Tags auth
 // @Accept json
 // @Produce json
-// @Success 200 {object} map[string]string "Success"
+// @Param input body models.RefreshTokenForm false "Refresh token input"
+// @Success 200 {object} helper.Response "Success"
 // @Router /logout [get]
+// @Router /logout [post]
 func Logout(w http.ResponseWriter, r *http.Request) {
+	refreshTokenRepo := repositories.NewUserRefreshTokenRepository()
+
+	// revoke refresh token family and the access token issued with it
+	if refreshToken := getRefreshTokenString(r); refreshToken != "" {
+		if existRefreshToken, err := refreshTokenRepo.ReadByTokenHash(helper.HashToken(refreshToken)); err == nil {
+			if err := refreshTokenRepo.RevokeByFamilyID(existRefreshToken.FamilyID); err != nil {
+				helper.ResponseDatabaseError(w, err, err.Error())
+				return
+			}
+		}
+	}
+
+	// revoke current access token too, in case refresh token not sent
+	if tokenString, err := helper.GetTokenString(r); err == nil {
+		if claims, err := helper.ParseJWTClaim(tokenString); err == nil && claims.ID != "" && claims.ExpiresAt != nil {
+			revokedTokenRepo := repositories.NewRevokedTokenRepository()
+			if err := revokedTokenRepo.Create(claims.ID, claims.Id, claims.ExpiresAt.Time); err != nil {
+				helper.ResponseDatabaseError(w, err, err.Error())
+				return
+			}
+			revokedTokenRepo.DeleteExpired()
+		}
+	}
+
 	// remove token from cookie
 	http.SetCookie(w, &http.Cookie{
 		Name:     "token",
@@ -153,9 +353,15 @@ func Logout(w http.ResponseWriter, r *http.Request) {
 		HttpOnly: true,
 		MaxAge:   -1,
 	})
+	http.SetCookie(w, &http.Cookie{
+		Name:     "refresh_token",
+		Path:     "/",
+		Value:    "",
+		HttpOnly: true,
+		MaxAge:   -1,
+	})
 
-	response := map[string]string{"message": "logout success"}
-	helper.ResponseJSON(w, http.StatusOK, response)
+	helper.ResponseJSON(w, http.StatusOK, helper.Response{Message: "logout success"})
 }
 
 // Profile godoc
@@ -164,25 +370,35 @@ func Logout(w http.ResponseWriter, r *http.Request) {
 // @Tags auth
 // @Accept json
 // @Produce json
-// @Success 200 {object} map[string]string "Success"
+// @Success 200 {object} helper.Response "Success"
 // @Router /profile [get]
 func Profile(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 
 	userRepo := repositories.NewUserRepository()
 	user, err := userRepo.Read(jwtClaim.Id)
 	if err != nil {
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
-			"id":       user.ID,
-			"username": user.Username,
-			"name":     user.Name,
+	userMFARepo := repositories.NewUserMFARepository()
+	userMFA, err := userMFARepo.ReadByUserID(user.ID)
+	mfaEnabled := err == nil && userMFA.IsEnabled
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"id":                  user.ID,
+			"username":            user.Username,
+			"name":                user.Name,
+			"role":                user.Role,
+			"mfa_enabled":         mfaEnabled,
+			"default_language_id": user.DefaultLanguageID,
 		},
 	}
 
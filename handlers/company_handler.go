@@ -1,14 +1,14 @@
 package handlers
 
 import (
-	"math"
+	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -25,15 +25,16 @@ import (
 // @Param is_external_url formData bool false "Is external URL"
 // @Param is_external_image_url formData bool false "Is external image URL"
 // @Param address formData string false "Company address"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /company [post]
 func CreateCompany(w http.ResponseWriter, r *http.Request) {
 	err := r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
@@ -43,60 +44,65 @@ func CreateCompany(w http.ResponseWriter, r *http.Request) {
 	address := r.FormValue("address")
 	isExternalUrl := helper.ParseIDStringToBool(r.FormValue("is_external_url"))
 	isExternalImageUrl := helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+
+	// Create a new company record
+	newCompany := models.Company{
+		Code:               code,
+		Name:               name,
+		Url:                url,
+		Address:            address,
+		IsExternalUrl:      isExternalUrl,
+		IsExternalImageUrl: isExternalImageUrl,
+	}
+	if fieldErrors := helper.ValidateStruct(newCompany); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("image_file")
+	file, _, err := r.FormFile("image_file")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	companyRepo := repositories.NewCompanyRepository()
 	// validation name and code unique
-	exist_company, _ := companyRepo.ReadByNameOrCode(name, code)
-	if exist_company != nil {
-		// Handle error
-		response := map[string]string{"message": "Name or Code already used"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+	exist, err := companyRepo.ExistsByNameOrCode(name, code, 0)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
 		return
 	}
-
-	// validation location image
-	var directory = "./assets/images/company"
-	if err := os.MkdirAll(directory, 0755); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
 		return
 	}
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	var finalFilename = code + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
 
-	// Create a new company record
-	newCompany := models.Company{
-		Code:               code,
-		Name:               name,
-		ImageUrl:           imageUrl,
-		Url:                url,
-		Address:            address,
-		IsExternalUrl:      isExternalUrl,
-		IsExternalImageUrl: isExternalImageUrl,
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryCompany)
+	if !ok {
+		return
 	}
+	newCompany.ImageUrl = imageUrl
+
 	// insert to database
-	if newCompany, err := companyRepo.Create(&newCompany); err != nil {
+	if newCompany, err := unitOfWork.CompanyRepository().Create(&newCompany); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new company"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new company")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new company")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newCompany.ID,
 			},
 		}
@@ -113,32 +119,51 @@ func CreateCompany(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
+// @Param q query string false "Search code or name"
+// @Param sort query string false "Sort column" Enums(id, code, name, created_at, updated_at) default(id)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param created_from query string false "Created at from, YYYY-MM-DD or RFC3339"
+// @Param created_to query string false "Created at to (inclusive), YYYY-MM-DD or RFC3339"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /company [get]
 func GetFilteredPaginatedCompanies(w http.ResponseWriter, r *http.Request) {
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	companyRepo := repositories.NewCompanyRepository()
-	totalCount, err := companyRepo.Count()
+	listQuery, err := helper.ParseListQuery(r, repositories.MasterDataSortColumns)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
 		return
 	}
+	pagination := listQuery.Pagination
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	companyRepo := repositories.NewCompanyRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = companyRepo.Count(listQuery)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
 
-	companies, err := companyRepo.ReadFilteredPaginated(pageSize, pageNumber)
+	companies, err := companyRepo.ReadFilteredPaginated(listQuery)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch companies"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch companies")
 		return
 	}
 
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		companies, nextCursor, prevCursor, err = helper.BuildCursorPage(companies, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
+	}
+
 	var filteredCompanies []models.CompanyAllResponse
 	for _, company := range companies {
 		filteredCompanies = append(filteredCompanies, models.CompanyAllResponse{
@@ -151,20 +176,14 @@ func GetFilteredPaginatedCompanies(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if filteredCompanies == nil {
-		response := map[string]string{"message": "companies not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "companies not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredCompanies,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredCompanies,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -177,16 +196,16 @@ func GetFilteredPaginatedCompanies(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Company ID"
-// @Success 200 {object} map[string]string "Success"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /company/{id} [get]
 func ReadCompany(w http.ResponseWriter, r *http.Request) {
 	// Extract company ID from the request URL
 	vars := mux.Vars(r)
 	companyIDStr, ok := vars["id"]
 	if !ok {
-		http.Error(w, "Company ID not provided", http.StatusBadRequest)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not provided")
 		return
 	}
 
@@ -195,16 +214,15 @@ func ReadCompany(w http.ResponseWriter, r *http.Request) {
 	companyRepo := repositories.NewCompanyRepository()
 	company, err := companyRepo.Read(companyID)
 	if err != nil {
-		response := map[string]string{"message": "Company ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Company ID not found")
 		return
 	}
 
-	fullImageURL := helper.GetFullImageUrl(company.ImageUrl, r)
+	fullImageURL := storages.GetPublicURL(company.ImageUrl)
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
 			"id":                    company.ID,
 			"code":                  company.Code,
 			"name":                  company.Name,
@@ -220,3 +238,183 @@ func ReadCompany(w http.ResponseWriter, r *http.Request) {
 
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
+
+// UpdateCompany godoc
+// @Summary Update company
+// @Description Update company with optional file replacement, only provided fields are changed
+// @Tags companies
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "Company ID"
+// @Param code formData string false "Company code"
+// @Param name formData string false "Company name"
+// @Param url formData string false "Company URL"
+// @Param address formData string false "Company address"
+// @Param is_external_url formData bool false "Is external URL"
+// @Param is_external_image_url formData bool false "Is external image URL"
+// @Param image_file formData file false "Company image file"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /company/{id} [patch]
+func UpdateCompany(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	companyIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not provided")
+		return
+	}
+
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
+		return
+	}
+
+	companyRepo := repositories.NewCompanyRepository()
+	companyID := helper.ParseIDStringToInt(companyIDStr)
+	company, err := companyRepo.Read(companyID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Company ID not found")
+		return
+	}
+
+	if r.PostForm.Has("code") {
+		company.Code = r.FormValue("code")
+	}
+	if r.PostForm.Has("name") {
+		company.Name = r.FormValue("name")
+	}
+
+	// validation name and code unique in other company
+	exist, err := companyRepo.ExistsByNameOrCode(company.Name, company.Code, company.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
+		return
+	}
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
+		return
+	}
+
+	if r.PostForm.Has("url") {
+		company.Url = r.FormValue("url")
+	}
+	if r.PostForm.Has("address") {
+		company.Address = r.FormValue("address")
+	}
+	if r.PostForm.Has("is_external_url") {
+		company.IsExternalUrl = helper.ParseIDStringToBool(r.FormValue("is_external_url"))
+	}
+	if r.PostForm.Has("is_external_image_url") {
+		company.IsExternalImageUrl = helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+	}
+
+	if fieldErrors := helper.ValidateStruct(company); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := company.ImageUrl
+	file, _, err := r.FormFile("image_file")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryCompany)
+		if !ok {
+			return
+		}
+		company.ImageUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.CompanyRepository().Update(company); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update company")
+		return
+	}
+
+	if storages.KeyFromPath(company.ImageUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update company")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// DeleteCompany godoc
+// @Summary Delete company
+// @Description Delete company, refused while user experience rows still reference it unless force is true
+// @Tags companies
+// @Accept json
+// @Produce json
+// @Param id path int true "Company ID"
+// @Param force query bool false "Delete together with referencing rows"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /company/{id} [delete]
+func DeleteCompany(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	companyIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not provided")
+		return
+	}
+
+	force := helper.ParseIDStringToBool(r.URL.Query().Get("force"))
+
+	companyRepo := repositories.NewCompanyRepository()
+	companyID := helper.ParseIDStringToInt(companyIDStr)
+	company, err := companyRepo.Read(companyID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Company ID not found")
+		return
+	}
+
+	// the foreign keys cascade, so deleting a used company also deletes user data
+	totalReference, err := companyRepo.CountReferences(companyID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch total reference")
+		return
+	}
+
+	if totalReference > 0 && !force {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, fmt.Sprintf("Company still used by %d user experience rows, use force=true to delete", totalReference))
+		return
+	}
+
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	if err := unitOfWork.CompanyRepository().Delete(companyID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete company")
+		return
+	}
+
+	storages.DeleteImage(unitOfWork.Files, company.ImageUrl)
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete company")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
@@ -1,14 +1,14 @@
 package handlers
 
 import (
-	"math"
+	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -21,70 +21,76 @@ import (
 // @Param code formData string true "Language code"
 // @Param name formData string true "Language name"
 // @Param logo_url formData file true "Language logo file"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /language [post]
 func CreateLanguage(w http.ResponseWriter, r *http.Request) {
 	err := r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
 	code := r.FormValue("code")
 	name := r.FormValue("name")
+
+	// Create a new language record
+	newLangugage := models.Language{
+		Code: code,
+		Name: name,
+	}
+	if fieldErrors := helper.ValidateStruct(newLangugage); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("logo_url")
+	file, _, err := r.FormFile("logo_url")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	languageRepo := repositories.NewLanguageRepository()
 	// validation name and code unique
-	exist_language, _ := languageRepo.ReadByNameOrCode(name, code)
-	if exist_language != nil {
-		// Handle error
-		response := map[string]string{"message": "Name or Code already used"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+	exist, err := languageRepo.ExistsByNameOrCode(name, code, 0)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
 		return
 	}
-
-	// validation location image
-	var directory = "./assets/images/language"
-	if err := os.MkdirAll(directory, 0755); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
 		return
 	}
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	var finalFilename = code + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
 
-	// Create a new language record
-	newLangugage := models.Language{
-		Code:    code,
-		Name:    name,
-		LogoUrl: imageUrl,
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "logo_url", imageDirectoryLanguage)
+	if !ok {
+		return
 	}
+	newLangugage.LogoUrl = imageUrl
+
 	// insert to database
-	if newLanguage, err := languageRepo.Create(&newLangugage); err != nil {
+	if newLanguage, err := unitOfWork.LanguageRepository().Create(&newLangugage); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new language"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new language")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new language")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newLanguage.ID,
 			},
 		}
@@ -101,35 +107,54 @@ func CreateLanguage(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
-// @Success 200 {object} map[string][]models.LanguageResponse "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
+// @Param q query string false "Search code or name"
+// @Param sort query string false "Sort column" Enums(id, code, name, created_at, updated_at) default(id)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param created_from query string false "Created at from, YYYY-MM-DD or RFC3339"
+// @Param created_to query string false "Created at to (inclusive), YYYY-MM-DD or RFC3339"
+// @Success 200 {object} helper.Response{data=[]models.LanguageResponse} "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /language [get]
 func GetFilteredPaginatedLanguages(w http.ResponseWriter, r *http.Request) {
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	languageRepo := repositories.NewLanguageRepository()
-	totalCount, err := languageRepo.Count()
+	listQuery, err := helper.ParseListQuery(r, repositories.MasterDataSortColumns)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
 		return
 	}
+	pagination := listQuery.Pagination
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	languageRepo := repositories.NewLanguageRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = languageRepo.Count(listQuery)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
 
-	languages, err := languageRepo.ReadFilteredPaginated(pageSize, pageNumber)
+	languages, err := languageRepo.ReadFilteredPaginated(listQuery)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch languages"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch languages")
 		return
 	}
 
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		languages, nextCursor, prevCursor, err = helper.BuildCursorPage(languages, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
+	}
+
 	var filteredLanguages []models.LanguageResponse
 	for _, language := range languages {
-		fullImageURL := helper.GetFullImageUrl(language.LogoUrl, r)
+		fullImageURL := storages.GetPublicURL(language.LogoUrl)
 
 		filteredLanguages = append(filteredLanguages, models.LanguageResponse{
 			ID:        language.ID,
@@ -142,20 +167,14 @@ func GetFilteredPaginatedLanguages(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if filteredLanguages == nil {
-		response := map[string]string{"message": "languages not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "languages not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredLanguages,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredLanguages,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -168,16 +187,16 @@ func GetFilteredPaginatedLanguages(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Language ID"
-// @Success 200 {object} models.LanguageResponse "Success"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response{data=models.LanguageResponse} "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /language/{id} [get]
 func ReadLanguage(w http.ResponseWriter, r *http.Request) {
 	// Extract language ID from the request URL
 	vars := mux.Vars(r)
 	languageIDStr, ok := vars["id"]
 	if !ok {
-		http.Error(w, "Language ID not provided", http.StatusBadRequest)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
 		return
 	}
 
@@ -186,12 +205,11 @@ func ReadLanguage(w http.ResponseWriter, r *http.Request) {
 	languageRepo := repositories.NewLanguageRepository()
 	language, err := languageRepo.Read(languageID)
 	if err != nil {
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Language ID not found")
 		return
 	}
 
-	fullImageURL := helper.GetFullImageUrl(language.LogoUrl, r)
+	fullImageURL := storages.GetPublicURL(language.LogoUrl)
 
 	var responseData = models.LanguageResponse{
 		ID:        language.ID,
@@ -202,10 +220,173 @@ func ReadLanguage(w http.ResponseWriter, r *http.Request) {
 		UpdatedAt: language.UpdatedAt,
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    responseData,
+	response := helper.Response{
+		Message: "success",
+		Data:    responseData,
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// UpdateLanguage godoc
+// @Summary Update language
+// @Description Update language with optional file replacement, only provided fields are changed
+// @Tags languages
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "Language ID"
+// @Param code formData string false "Language code"
+// @Param name formData string false "Language name"
+// @Param logo_url formData file false "Language logo file"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /language/{id} [patch]
+func UpdateLanguage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	languageIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
+		return
+	}
+
+	languageRepo := repositories.NewLanguageRepository()
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+	language, err := languageRepo.Read(languageID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Language ID not found")
+		return
+	}
+
+	if r.PostForm.Has("code") {
+		language.Code = r.FormValue("code")
+	}
+	if r.PostForm.Has("name") {
+		language.Name = r.FormValue("name")
 	}
 
+	// validation name and code unique in other language
+	exist, err := languageRepo.ExistsByNameOrCode(language.Name, language.Code, language.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
+		return
+	}
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
+		return
+	}
+
+	if fieldErrors := helper.ValidateStruct(language); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := language.LogoUrl
+	file, _, err := r.FormFile("logo_url")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "logo_url", imageDirectoryLanguage)
+		if !ok {
+			return
+		}
+		language.LogoUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.LanguageRepository().Update(language); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update language")
+		return
+	}
+
+	if storages.KeyFromPath(language.LogoUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update language")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// DeleteLanguage godoc
+// @Summary Delete language
+// @Description Delete language, refused while user language or translation rows still reference it unless force is true
+// @Tags languages
+// @Accept json
+// @Produce json
+// @Param id path int true "Language ID"
+// @Param force query bool false "Delete together with referencing rows"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /language/{id} [delete]
+func DeleteLanguage(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	languageIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	force := helper.ParseIDStringToBool(r.URL.Query().Get("force"))
+
+	languageRepo := repositories.NewLanguageRepository()
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+	language, err := languageRepo.Read(languageID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Language ID not found")
+		return
+	}
+
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	// the foreign keys cascade, so deleting a used language also deletes user data and translations
+	totalReference, err := unitOfWork.LanguageRepository().CountReferences(languageID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch total reference")
+		return
+	}
+
+	if totalReference > 0 && !force {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, fmt.Sprintf("Language still used by %d user language or translation rows, use force=true to delete", totalReference))
+		return
+	}
+
+	if err := unitOfWork.LanguageRepository().Delete(languageID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete language")
+		return
+	}
+
+	storages.DeleteImage(unitOfWork.Files, language.LogoUrl)
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete language")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/gorilla/mux"
+)
+
+// get locked login godoc
+// @Summary Get Locked Login
+// @Description Get list of username and ip which currently locked by failed login attempts, admin only
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param type query string false "Filter by type (username, ip, reset_username or reset_ip)"
+// @Param size query int false "Page size"
+// @Param offset query int false "Page number"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /login-lock [get]
+func GetLockedLoginAttempts(w http.ResponseWriter, r *http.Request) {
+	typeFilter := r.URL.Query().Get("type")
+	pagination, err := helper.ParsePagination(r, "locked_until", helper.SortOrderDesc)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	loginAttemptRepo := repositories.NewLoginAttemptRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = loginAttemptRepo.CountLocked(typeFilter)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
+
+	loginAttempts, err := loginAttemptRepo.ReadLockedPaginated(typeFilter, pagination)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch locked login")
+		return
+	}
+
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		loginAttempts, nextCursor, prevCursor, err = helper.BuildCursorPage(loginAttempts, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
+	}
+
+	if len(loginAttempts) == 0 {
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "locked login not found")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data:    loginAttempts,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// unlock login godoc
+// @Summary Unlock Login
+// @Description Clear failed login counter and lock of username or ip, admin only
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param id path int true "Login lock ID"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /login-lock/{id} [delete]
+func UnlockLoginAttempt(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	loginAttemptIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Login lock ID not provided in URL")
+		return
+	}
+
+	loginAttemptRepo := repositories.NewLoginAttemptRepository()
+	loginAttempt, err := loginAttemptRepo.Read(helper.ParseIDStringToInt(loginAttemptIDStr))
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Login lock ID not found")
+		return
+	}
+
+	if err := loginAttemptRepo.Reset(loginAttempt.ID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to unlock login")
+		return
+	}
+
+	// keep unlock in audit trail
+	username, ipAddress := loginAttempt.Value, ""
+	if loginAttempt.Type == models.LoginAttemptTypeIP || loginAttempt.Type == models.LoginAttemptTypeResetIP {
+		username, ipAddress = "", loginAttempt.Value
+	}
+	loginEventRepo := repositories.NewLoginEventRepository()
+	if err := loginEventRepo.Create(username, ipAddress, models.LoginEventUnlocked); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to record login event")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// get login event godoc
+// @Summary Get Login Event
+// @Description Get list of login event (success, failed, locked, unlocked), admin only
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param username query string false "Filter by username"
+// @Param event query string false "Filter by event"
+// @Param size query int false "Page size"
+// @Param offset query int false "Page number"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /login-event [get]
+func GetLoginEvents(w http.ResponseWriter, r *http.Request) {
+	usernameFilter := r.URL.Query().Get("username")
+	eventFilter := r.URL.Query().Get("event")
+	pagination, err := helper.ParsePagination(r, "created_at", helper.SortOrderDesc)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	loginEventRepo := repositories.NewLoginEventRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = loginEventRepo.Count(usernameFilter, eventFilter)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
+
+	loginEvents, err := loginEventRepo.ReadFilteredPaginated(usernameFilter, eventFilter, pagination)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch login event")
+		return
+	}
+
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		loginEvents, nextCursor, prevCursor, err = helper.BuildCursorPage(loginEvents, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
+	}
+
+	if len(loginEvents) == 0 {
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "login event not found")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data:    loginEvents,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+)
+
+// username key is case insensitive and limited to username column size, cut on character so it stay valid utf-8
+func loginUsernameKey(username string) string {
+	key := []rune(strings.ToLower(strings.TrimSpace(username)))
+	if len(key) > 48 {
+		key = key[:48]
+	}
+	return string(key)
+}
+
+// longest backoff left of the counters by type, 0 when none is locked
+func throttleRetryAfter(values map[string]string) time.Duration {
+	loginAttemptRepo := repositories.NewLoginAttemptRepository()
+	now := time.Now()
+
+	var retryAfter time.Duration
+	for attemptType, value := range values {
+		loginAttempt, err := loginAttemptRepo.ReadByTypeValue(attemptType, value)
+		if err != nil || loginAttempt.LockedUntil == nil {
+			continue
+		}
+		if wait := loginAttempt.LockedUntil.Sub(now); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+	return retryAfter
+}
+
+// reject login when username or ip still in backoff, return false when response already written
+func checkLoginThrottle(w http.ResponseWriter, username, clientIP string) bool {
+	retryAfter := throttleRetryAfter(map[string]string{
+		models.LoginAttemptTypeUsername: username,
+		models.LoginAttemptTypeIP:       clientIP,
+	})
+	if retryAfter <= 0 {
+		return true
+	}
+
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	helper.ResponseError(w, http.StatusTooManyRequests, helper.ErrorCodeTooManyRequests, "Too many failed login attempts, please try again later")
+	return false
+}
+
+// count failed login for username and ip, counter error must not break login response
+func registerLoginFailure(username, clientIP string, throttle config.LoginThrottleConfig) {
+	loginAttemptRepo := repositories.NewLoginAttemptRepository()
+	loginEventRepo := repositories.NewLoginEventRepository()
+
+	if err := loginEventRepo.Create(username, clientIP, models.LoginEventFailed); err != nil {
+		log.Println("Failed to record login event: ", err)
+	}
+
+	if _, locked, err := loginAttemptRepo.RegisterFailure(models.LoginAttemptTypeUsername, username, throttle.UsernameMaxAttempts, throttle); err != nil {
+		log.Println("Failed to register login failure: ", err)
+	} else if locked {
+		if err := loginEventRepo.Create(username, clientIP, models.LoginEventLocked); err != nil {
+			log.Println("Failed to record login event: ", err)
+		}
+	}
+
+	if _, locked, err := loginAttemptRepo.RegisterFailure(models.LoginAttemptTypeIP, clientIP, throttle.IPMaxAttempts, throttle); err != nil {
+		log.Println("Failed to register login failure: ", err)
+	} else if locked {
+		if err := loginEventRepo.Create(username, clientIP, models.LoginEventLocked); err != nil {
+			log.Println("Failed to record login event: ", err)
+		}
+	}
+}
+
+// success login clear username counter, ip counter only cleared by window or admin
+func registerLoginSuccess(username, clientIP string) {
+	loginAttemptRepo := repositories.NewLoginAttemptRepository()
+	loginEventRepo := repositories.NewLoginEventRepository()
+
+	if err := loginAttemptRepo.ResetByTypeValue(models.LoginAttemptTypeUsername, username); err != nil {
+		log.Println("Failed to reset login attempt: ", err)
+	}
+	if err := loginEventRepo.Create(username, clientIP, models.LoginEventSuccess); err != nil {
+		log.Println("Failed to record login event: ", err)
+	}
+}
+
+// password reset request use the login backoff on its own counters, false when username or ip still in backoff
+func isPasswordResetAllowed(username, clientIP string) bool {
+	return throttleRetryAfter(map[string]string{
+		models.LoginAttemptTypeResetUsername: username,
+		models.LoginAttemptTypeResetIP:       clientIP,
+	}) <= 0
+}
+
+// every password reset request is counted, whether the username exist or not
+func registerPasswordResetRequest(username, clientIP string, throttle config.LoginThrottleConfig) {
+	loginAttemptRepo := repositories.NewLoginAttemptRepository()
+
+	if _, _, err := loginAttemptRepo.RegisterFailure(models.LoginAttemptTypeResetUsername, username, throttle.UsernameMaxAttempts, throttle); err != nil {
+		log.Println("Failed to register password reset request: ", err)
+	}
+	if _, _, err := loginAttemptRepo.RegisterFailure(models.LoginAttemptTypeResetIP, clientIP, throttle.IPMaxAttempts, throttle); err != nil {
+		log.Println("Failed to register password reset request: ", err)
+	}
+}
@@ -0,0 +1,25 @@
+package handlers
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestLoginUsernameKey(t *testing.T) {
+	tests := map[string]string{
+		"  Admin ":                    "admin",
+		strings.Repeat("a", 60):       strings.Repeat("a", 48),
+		strings.Repeat("é", 60):       strings.Repeat("é", 48),
+		"a" + strings.Repeat("日", 50): "a" + strings.Repeat("日", 47),
+	}
+	for username, want := range tests {
+		got := loginUsernameKey(username)
+		if got != want {
+			t.Errorf("loginUsernameKey(%q) = %q, want %q", username, got, want)
+		}
+		if !utf8.ValidString(got) {
+			t.Errorf("loginUsernameKey(%q) = %q is not valid utf-8", username, got)
+		}
+	}
+}
@@ -0,0 +1,425 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Enroll MFA godoc
+// @Summary Enroll two factor authentication
+// @Description Create new TOTP secret and provisioning uri to be shown as qr code, mfa is enabled after the first code verified
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /profile/mfa/enroll [post]
+func EnrollMFA(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	userMFARepo := repositories.NewUserMFARepository()
+	if userMFA, err := userMFARepo.ReadByUserID(jwtClaim.Id); err == nil && userMFA.IsEnabled {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Two factor authentication already enabled")
+		return
+	}
+
+	secret, err := helper.GenerateTOTPSecret()
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	if _, err := userMFARepo.SavePending(jwtClaim.Id, helper.EncodeTOTPSecret(secret)); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to save two factor authentication")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"secret":           helper.EncodeTOTPSecret(secret),
+			"provisioning_uri": helper.TOTPProvisioningURI(config.GetMFAConfig().Issuer, jwtClaim.Username, secret),
+		},
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// Verify MFA godoc
+// @Summary Verify two factor authentication
+// @Description Enable two factor authentication with the first TOTP code, recovery codes are only shown once
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserMFACodeForm true "TOTP code input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /profile/mfa/verify [post]
+func VerifyMFA(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var codeInput models.UserMFACodeForm
+	if err := json.NewDecoder(r.Body).Decode(&codeInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode mfa code input")
+		return
+	}
+	defer r.Body.Close()
+
+	userMFARepo := repositories.NewUserMFARepository()
+	userMFA, err := userMFARepo.ReadByUserID(jwtClaim.Id)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Two factor authentication not enrolled")
+		return
+	}
+	if userMFA.IsEnabled {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Two factor authentication already enabled")
+		return
+	}
+
+	totp, err := newUserTOTP(userMFA)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	counter, ok := totp.Validate(codeInput.Code)
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Code invalid")
+		return
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes(config.GetMFAConfig().RecoveryCodeCount)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	if err := userMFARepo.Enable(userMFA.ID, counter, recoveryCodeHashes); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to enable two factor authentication")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"recovery_codes": recoveryCodes,
+		},
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// Disable MFA godoc
+// @Summary Disable two factor authentication
+// @Description Disable two factor authentication, require current password and TOTP or recovery code
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserMFADisableForm true "Disable input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Router /profile/mfa [delete]
+func DisableMFA(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var disableInput models.UserMFADisableForm
+	if err := json.NewDecoder(r.Body).Decode(&disableInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode mfa disable input")
+		return
+	}
+	defer r.Body.Close()
+
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.Read(jwtClaim.Id)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User ID not found")
+		return
+	}
+	if err := userRepo.CompareUserPassword(user.Password, disableInput.Password); err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Password invalid")
+		return
+	}
+
+	userMFARepo := repositories.NewUserMFARepository()
+	userMFA, err := userMFARepo.ReadByUserID(user.ID)
+	if err != nil || !userMFA.IsEnabled {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Two factor authentication not enabled")
+		return
+	}
+
+	ok, err := verifyMFACode(userMFA, disableInput.Code)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+	if !ok {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Code invalid")
+		return
+	}
+
+	if err := userMFARepo.DeleteByUserID(user.ID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to disable two factor authentication")
+		return
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, helper.Response{Message: "success"})
+}
+
+// Regenerate Recovery Codes godoc
+// @Summary Regenerate recovery codes
+// @Description Replace every recovery code, require TOTP code
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserMFACodeForm true "TOTP code input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Router /profile/mfa/recovery-codes [post]
+func RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var codeInput models.UserMFACodeForm
+	if err := json.NewDecoder(r.Body).Decode(&codeInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode mfa code input")
+		return
+	}
+	defer r.Body.Close()
+
+	userMFARepo := repositories.NewUserMFARepository()
+	userMFA, err := userMFARepo.ReadByUserID(jwtClaim.Id)
+	if err != nil || !userMFA.IsEnabled {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Two factor authentication not enabled")
+		return
+	}
+
+	totp, err := newUserTOTP(userMFA)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+	counter, ok := totp.Validate(codeInput.Code)
+	if ok {
+		ok, err = userMFARepo.UseCounter(userMFA.ID, counter)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, err.Error())
+			return
+		}
+	}
+	if !ok {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Code invalid")
+		return
+	}
+
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes(config.GetMFAConfig().RecoveryCodeCount)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	recoveryCodeRepo := repositories.NewUserRecoveryCodeRepository()
+	if err := recoveryCodeRepo.Replace(jwtClaim.Id, recoveryCodeHashes); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to save recovery codes")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"recovery_codes": recoveryCodes,
+		},
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// Login MFA godoc
+// @Summary Login second step
+// @Description Exchange mfa_token from login and TOTP or recovery code with access token and refresh token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserMFALoginForm true "MFA login input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Failure 429 {object} helper.Response "Too Many Requests"
+// @Router /login/mfa [post]
+func LoginMFA(w http.ResponseWriter, r *http.Request) {
+	var mfaInput models.UserMFALoginForm
+	if err := json.NewDecoder(r.Body).Decode(&mfaInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode mfa login input")
+		return
+	}
+	defer r.Body.Close()
+
+	claims, err := helper.ParseJWTClaim(mfaInput.MFAToken)
+	if err != nil || claims.TokenType != config.TokenTypeMFAPending || claims.ID == "" || claims.ExpiresAt == nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "MFA token invalid or expired")
+		return
+	}
+
+	// mfa token can only be used once
+	revokedTokenRepo := repositories.NewRevokedTokenRepository()
+	revoked, err := revokedTokenRepo.IsRevoked(claims.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, err.Error())
+		return
+	}
+	if revoked {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "MFA token invalid or expired")
+		return
+	}
+
+	// code guessing share the same counter with password guessing
+	throttle := config.GetLoginThrottleConfig()
+	clientIP := helper.GetClientIP(r, throttle.TrustProxy)
+	usernameKey := loginUsernameKey(claims.Username)
+	if !checkLoginThrottle(w, usernameKey, clientIP) {
+		return
+	}
+
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.Read(claims.Id)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "MFA token invalid or expired")
+		return
+	}
+
+	userMFARepo := repositories.NewUserMFARepository()
+	userMFA, err := userMFARepo.ReadByUserID(user.ID)
+	if err != nil || !userMFA.IsEnabled {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Two factor authentication not enabled")
+		return
+	}
+
+	ok, err := verifyMFACode(userMFA, mfaInput.Code)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+	if !ok {
+		registerLoginFailure(usernameKey, clientIP, throttle)
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Code invalid")
+		return
+	}
+
+	if err := revokedTokenRepo.Create(claims.ID, claims.Id, claims.ExpiresAt.Time); err != nil {
+		helper.ResponseDatabaseError(w, err, err.Error())
+		return
+	}
+	registerLoginSuccess(usernameKey, clientIP)
+
+	// new login start new refresh token family
+	familyID, err := helper.GenerateRandomToken(16)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	response, err := issueToken(w, user, familyID, nil)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+	helper.ResponseJSON(w, http.StatusOK, *response)
+}
+
+// short lived token which only accepted by /login/mfa
+func issueMFAPendingToken(user *models.User) (*helper.Response, error) {
+	now := time.Now()
+	expTime := now.Add(config.GetMFAConfig().PendingTokenDuration)
+
+	jti, err := helper.GenerateRandomToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &config.JWTClaim{
+		Id:        user.ID,
+		Username:  user.Username,
+		TokenType: config.TokenTypeMFAPending,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			Issuer:    "go-jwt-mux",
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expTime),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(config.JWT_KEY)
+	if err != nil {
+		return nil, err
+	}
+
+	response := helper.Response{
+		Message: "mfa required",
+		Data: map[string]string{
+			"mfa_required": "true",
+			"mfa_token":    token,
+			"expired":      expTime.String(),
+		},
+	}
+	return &response, nil
+}
+
+func newUserTOTP(userMFA *models.UserMFA) (*helper.TOTP, error) {
+	secret, err := helper.DecodeTOTPSecret(userMFA.Secret)
+	if err != nil {
+		return nil, err
+	}
+	return helper.NewTOTP(secret), nil
+}
+
+// accept TOTP code once per time step, otherwise try unused recovery code
+func verifyMFACode(userMFA *models.UserMFA, code string) (bool, error) {
+	totp, err := newUserTOTP(userMFA)
+	if err != nil {
+		return false, err
+	}
+
+	if counter, ok := totp.Validate(code); ok {
+		return repositories.NewUserMFARepository().UseCounter(userMFA.ID, counter)
+	}
+
+	recoveryCodeRepo := repositories.NewUserRecoveryCodeRepository()
+	return recoveryCodeRepo.Use(int64(userMFA.UserID), helper.HashToken(helper.NormalizeRecoveryCode(code)))
+}
+
+// plain codes are returned to user once, only the hashes are saved
+func generateRecoveryCodes(count int) ([]string, []string, error) {
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		code, err := helper.GenerateRecoveryCode()
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, helper.HashToken(helper.NormalizeRecoveryCode(code)))
+	}
+	return codes, hashes, nil
+}
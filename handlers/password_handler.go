@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/notifiers"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"gorm.io/gorm"
+)
+
+// Change Password godoc
+// @Summary Change password
+// @Description Change password of logged in user, every session is revoked after password changed
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserPasswordChangeForm true "Password input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Router /profile/password [put]
+func ChangePassword(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var passwordInput models.UserPasswordChangeForm
+	if err := json.NewDecoder(r.Body).Decode(&passwordInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode password input")
+		return
+	}
+	defer r.Body.Close()
+
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.Read(jwtClaim.Id)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User ID not found")
+		return
+	}
+
+	if err := userRepo.CompareUserPassword(user.Password, passwordInput.CurrentPassword); err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Current password invalid")
+		return
+	}
+
+	if passwordInput.CurrentPassword == passwordInput.NewPassword {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "New password must be different from current password")
+		return
+	}
+
+	if !validateNewPassword(w, passwordInput.NewPassword) {
+		return
+	}
+
+	if !setNewPassword(w, user.ID, passwordInput.NewPassword) {
+		return
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, helper.Response{Message: "success"})
+}
+
+// Forgot Password godoc
+// @Summary Request password reset
+// @Description Send password reset token through configured notifier, response is the same whether the username exist or not
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserPasswordForgotForm true "Username input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /password/forgot [post]
+func ForgotPassword(w http.ResponseWriter, r *http.Request) {
+	var forgotInput models.UserPasswordForgotForm
+	if err := json.NewDecoder(r.Body).Decode(&forgotInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode password forgot input")
+		return
+	}
+	defer r.Body.Close()
+
+	// do not tell whether the username exist
+	response := helper.Response{Message: "If the account exists, a password reset token has been sent"}
+
+	// every request replace the previous reset token, throttled request get the same response
+	throttle := config.GetLoginThrottleConfig()
+	clientIP := helper.GetClientIP(r, throttle.TrustProxy)
+	usernameKey := loginUsernameKey(forgotInput.Username)
+	if !isPasswordResetAllowed(usernameKey, clientIP) {
+		helper.ResponseJSON(w, http.StatusOK, response)
+		return
+	}
+	registerPasswordResetRequest(usernameKey, clientIP, throttle)
+
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.ReadByUsername(forgotInput.Username)
+	if err != nil {
+		helper.ResponseJSON(w, http.StatusOK, response)
+		return
+	}
+
+	token, err := helper.GenerateRandomToken(32)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	resetConfig := config.GetPasswordResetConfig()
+	newPasswordReset := models.UserPasswordReset{
+		UserID:    uint(user.ID),
+		TokenHash: helper.HashToken(token),
+		ExpiresAt: time.Now().Add(resetConfig.TokenDuration),
+	}
+
+	passwordResetRepo := repositories.NewUserPasswordResetRepository()
+	if _, err := passwordResetRepo.Create(&newPasswordReset); err != nil {
+		helper.ResponseDatabaseError(w, err, "Error creating password reset token")
+		return
+	}
+
+	notifier := notifiers.NewNotifier(resetConfig)
+	if err := notifier.SendPasswordReset(notifiers.PasswordResetMessage{
+		UserID:    user.ID,
+		Username:  user.Username,
+		Token:     token,
+		ExpiresAt: newPasswordReset.ExpiresAt,
+	}); err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to send password reset token")
+		return
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// Reset Password godoc
+// @Summary Reset password
+// @Description Set new password with password reset token, every session is revoked after password changed
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserPasswordResetForm true "Password reset input"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Router /password/reset [post]
+func ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var resetInput models.UserPasswordResetForm
+	if err := json.NewDecoder(r.Body).Decode(&resetInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode password reset input")
+		return
+	}
+	defer r.Body.Close()
+
+	passwordResetRepo := repositories.NewUserPasswordResetRepository()
+	passwordReset, err := passwordResetRepo.ReadByTokenHash(helper.HashToken(resetInput.Token))
+	if err != nil || passwordReset.UsedAt != nil || passwordReset.ExpiresAt.Before(time.Now()) {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Password reset token invalid or expired")
+		return
+	}
+
+	if !validateNewPassword(w, resetInput.NewPassword) {
+		return
+	}
+
+	// token can only be used once
+	userID := int64(passwordReset.UserID)
+	if err := passwordResetRepo.UseToUpdatePassword(passwordReset.ID, userID, resetInput.NewPassword); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Password reset token invalid or expired")
+			return
+		}
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to update password")
+		return
+	}
+
+	if !revokeUserSessions(w, userID) {
+		return
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, helper.Response{Message: "success"})
+}
+
+// validate password against configured policy
+func validateNewPassword(w http.ResponseWriter, password string) bool {
+	message, err := helper.ValidatePassword(password, config.GetPasswordPolicyConfig())
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return false
+	}
+	if message != "" {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, message)
+		return false
+	}
+
+	return true
+}
+
+// save new password and revoke every session of the user
+func setNewPassword(w http.ResponseWriter, userID int64, password string) bool {
+	userRepo := repositories.NewUserRepository()
+	if err := userRepo.UpdatePassword(userID, password); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update password")
+		return false
+	}
+
+	return revokeUserSessions(w, userID)
+}
+
+// revoke every refresh token and access token of the user
+func revokeUserSessions(w http.ResponseWriter, userID int64) bool {
+	refreshTokenRepo := repositories.NewUserRefreshTokenRepository()
+	if err := refreshTokenRepo.RevokeByUserID(userID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to revoke user sessions")
+		return false
+	}
+
+	return true
+}
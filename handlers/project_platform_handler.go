@@ -1,14 +1,14 @@
 package handlers
 
 import (
-	"math"
+	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -24,15 +24,16 @@ import (
 // @Param url formData string false "Project Platform URL"
 // @Param is_external_url formData bool false "Is external URL"
 // @Param is_external_image_url formData bool false "Is external image URL"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /project-platform [post]
 func CreateProjectPlatform(w http.ResponseWriter, r *http.Request) {
 	err := r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
@@ -41,59 +42,64 @@ func CreateProjectPlatform(w http.ResponseWriter, r *http.Request) {
 	url := r.FormValue("url")
 	isExternalUrl := helper.ParseIDStringToBool(r.FormValue("is_external_url"))
 	isExternalImageUrl := helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+
+	// Create a new projectPlatform record
+	newProjectPlatform := models.ProjectPlatform{
+		Code:               code,
+		Name:               name,
+		Url:                url,
+		IsExternalUrl:      isExternalUrl,
+		IsExternalImageUrl: isExternalImageUrl,
+	}
+	if fieldErrors := helper.ValidateStruct(newProjectPlatform); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("image_file")
+	file, _, err := r.FormFile("image_file")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	projectPlatformRepo := repositories.NewProjectPlatformRepository()
 	// validation name and code unique
-	exist_projectPlatform, _ := projectPlatformRepo.ReadByNameOrCode(name, code)
-	if exist_projectPlatform != nil {
-		// Handle error
-		response := map[string]string{"message": "Name or Code already used"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+	exist, err := projectPlatformRepo.ExistsByNameOrCode(name, code, 0)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
 		return
 	}
-
-	// validation location image
-	var directory = "./assets/images/project/platform"
-	if err := os.MkdirAll(directory, 0755); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
 		return
 	}
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	var finalFilename = code + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
 
-	// Create a new projectPlatform record
-	newProjectPlatform := models.ProjectPlatform{
-		Code:               code,
-		Name:               name,
-		ImageUrl:           imageUrl,
-		Url:                url,
-		IsExternalUrl:      isExternalUrl,
-		IsExternalImageUrl: isExternalImageUrl,
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryProjectPlatform)
+	if !ok {
+		return
 	}
+	newProjectPlatform.ImageUrl = imageUrl
+
 	// insert to database
-	if newProjectPlatform, err := projectPlatformRepo.Create(&newProjectPlatform); err != nil {
+	if newProjectPlatform, err := unitOfWork.ProjectPlatformRepository().Create(&newProjectPlatform); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new projectPlatform"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new projectPlatform")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new projectPlatform")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newProjectPlatform.ID,
 			},
 		}
@@ -110,35 +116,54 @@ func CreateProjectPlatform(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
+// @Param q query string false "Search code or name"
+// @Param sort query string false "Sort column" Enums(id, code, name, created_at, updated_at) default(id)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param created_from query string false "Created at from, YYYY-MM-DD or RFC3339"
+// @Param created_to query string false "Created at to (inclusive), YYYY-MM-DD or RFC3339"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /project-platform [get]
 func GetFilteredPaginatedProjectPlatforms(w http.ResponseWriter, r *http.Request) {
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	projectPlatformRepo := repositories.NewProjectPlatformRepository()
-	totalCount, err := projectPlatformRepo.Count()
+	listQuery, err := helper.ParseListQuery(r, repositories.MasterDataSortColumns)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
 		return
 	}
+	pagination := listQuery.Pagination
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	projectPlatformRepo := repositories.NewProjectPlatformRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = projectPlatformRepo.Count(listQuery)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
 
-	projectPlatforms, err := projectPlatformRepo.ReadFilteredPaginated(pageSize, pageNumber)
+	projectPlatforms, err := projectPlatformRepo.ReadFilteredPaginated(listQuery)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch projectPlatforms"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch projectPlatforms")
 		return
 	}
 
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		projectPlatforms, nextCursor, prevCursor, err = helper.BuildCursorPage(projectPlatforms, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
+	}
+
 	var filteredProjectPlatforms []models.ProjectPlatformAllResponse
 	for _, projectPlatform := range projectPlatforms {
-		fullImageURL := helper.GetFullImageUrl(projectPlatform.ImageUrl, r)
+		fullImageURL := storages.GetPublicURL(projectPlatform.ImageUrl)
 
 		filteredProjectPlatforms = append(filteredProjectPlatforms, models.ProjectPlatformAllResponse{
 			ID:        projectPlatform.ID,
@@ -151,20 +176,14 @@ func GetFilteredPaginatedProjectPlatforms(w http.ResponseWriter, r *http.Request
 	}
 
 	if filteredProjectPlatforms == nil {
-		response := map[string]string{"message": "projectPlatforms not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "projectPlatforms not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredProjectPlatforms,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredProjectPlatforms,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -177,16 +196,16 @@ func GetFilteredPaginatedProjectPlatforms(w http.ResponseWriter, r *http.Request
 // @Accept json
 // @Produce json
 // @Param id path int true "Project Platform ID"
-// @Success 200 {object} map[string]string "Success"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /project-platform/{id} [get]
 func ReadProjectPlatform(w http.ResponseWriter, r *http.Request) {
 	// Extract projectPlatform ID from the request URL
 	vars := mux.Vars(r)
 	projectPlatformIDStr, ok := vars["id"]
 	if !ok {
-		http.Error(w, "ProjectPlatform ID not provided", http.StatusBadRequest)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "ProjectPlatform ID not provided")
 		return
 	}
 
@@ -195,16 +214,15 @@ func ReadProjectPlatform(w http.ResponseWriter, r *http.Request) {
 	projectPlatformRepo := repositories.NewProjectPlatformRepository()
 	projectPlatform, err := projectPlatformRepo.Read(projectPlatformID)
 	if err != nil {
-		response := map[string]string{"message": "ProjectPlatform ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "ProjectPlatform ID not found")
 		return
 	}
 
-	fullImageURL := helper.GetFullImageUrl(projectPlatform.ImageUrl, r)
+	fullImageURL := storages.GetPublicURL(projectPlatform.ImageUrl)
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
 			"id":                    projectPlatform.ID,
 			"code":                  projectPlatform.Code,
 			"name":                  projectPlatform.Name,
@@ -219,3 +237,179 @@ func ReadProjectPlatform(w http.ResponseWriter, r *http.Request) {
 
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
+
+// UpdateProjectPlatform godoc
+// @Summary Update project platform
+// @Description Update project platform with optional file replacement, only provided fields are changed
+// @Tags project-platforms
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "Project Platform ID"
+// @Param code formData string false "Project Platform code"
+// @Param name formData string false "Project Platform name"
+// @Param url formData string false "Project Platform URL"
+// @Param is_external_url formData bool false "Is external URL"
+// @Param is_external_image_url formData bool false "Is external image URL"
+// @Param image_file formData file false "Project Platform image file"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /project-platform/{id} [patch]
+func UpdateProjectPlatform(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectPlatformIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Project Platform ID not provided")
+		return
+	}
+
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
+		return
+	}
+
+	projectPlatformRepo := repositories.NewProjectPlatformRepository()
+	projectPlatformID := helper.ParseIDStringToInt(projectPlatformIDStr)
+	projectPlatform, err := projectPlatformRepo.Read(projectPlatformID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Project Platform ID not found")
+		return
+	}
+
+	if r.PostForm.Has("code") {
+		projectPlatform.Code = r.FormValue("code")
+	}
+	if r.PostForm.Has("name") {
+		projectPlatform.Name = r.FormValue("name")
+	}
+
+	// validation name and code unique in other project platform
+	exist, err := projectPlatformRepo.ExistsByNameOrCode(projectPlatform.Name, projectPlatform.Code, projectPlatform.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
+		return
+	}
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
+		return
+	}
+
+	if r.PostForm.Has("url") {
+		projectPlatform.Url = r.FormValue("url")
+	}
+	if r.PostForm.Has("is_external_url") {
+		projectPlatform.IsExternalUrl = helper.ParseIDStringToBool(r.FormValue("is_external_url"))
+	}
+	if r.PostForm.Has("is_external_image_url") {
+		projectPlatform.IsExternalImageUrl = helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+	}
+
+	if fieldErrors := helper.ValidateStruct(projectPlatform); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := projectPlatform.ImageUrl
+	file, _, err := r.FormFile("image_file")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryProjectPlatform)
+		if !ok {
+			return
+		}
+		projectPlatform.ImageUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.ProjectPlatformRepository().Update(projectPlatform); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update project platform")
+		return
+	}
+
+	if storages.KeyFromPath(projectPlatform.ImageUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update project platform")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// DeleteProjectPlatform godoc
+// @Summary Delete project platform
+// @Description Delete project platform, refused while user project rows still reference it unless force is true
+// @Tags project-platforms
+// @Accept json
+// @Produce json
+// @Param id path int true "Project Platform ID"
+// @Param force query bool false "Delete together with referencing rows"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /project-platform/{id} [delete]
+func DeleteProjectPlatform(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectPlatformIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Project Platform ID not provided")
+		return
+	}
+
+	force := helper.ParseIDStringToBool(r.URL.Query().Get("force"))
+
+	projectPlatformRepo := repositories.NewProjectPlatformRepository()
+	projectPlatformID := helper.ParseIDStringToInt(projectPlatformIDStr)
+	projectPlatform, err := projectPlatformRepo.Read(projectPlatformID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Project Platform ID not found")
+		return
+	}
+
+	// the foreign keys cascade, so deleting a used project platform also deletes user data
+	totalReference, err := projectPlatformRepo.CountReferences(projectPlatformID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch total reference")
+		return
+	}
+
+	if totalReference > 0 && !force {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, fmt.Sprintf("Project Platform still used by %d user project rows, use force=true to delete", totalReference))
+		return
+	}
+
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	if err := unitOfWork.ProjectPlatformRepository().Delete(projectPlatformID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete project platform")
+		return
+	}
+
+	storages.DeleteImage(unitOfWork.Files, projectPlatform.ImageUrl)
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete project platform")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,8 +17,10 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.ProjectPlatformTranslationCreateForm true "Project Platform translation input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /project-platform-translation [post]
 func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 
@@ -27,10 +28,16 @@ func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 	var projectPlatformTranslationInput models.ProjectPlatformTranslationCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&projectPlatformTranslationInput); err != nil {
-		log.Fatal("Error decoding new project platform translation: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode project platform translation input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(projectPlatformTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	languageRepo := repositories.NewLanguageRepository()
 	projectPlatformRepo := repositories.NewProjectPlatformRepository()
 	projectPlatformTranslationRepo := repositories.NewProjectPlatformTranslationRepository()
@@ -39,8 +46,7 @@ func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 	_, lang_err := languageRepo.Read(projectPlatformTranslationInput.LanguageID)
 	if lang_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Lang ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Lang ID not found")
 		return
 	}
 
@@ -48,8 +54,7 @@ func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 	_, projectPlatform_err := projectPlatformRepo.Read(projectPlatformTranslationInput.ProjectPlatformID)
 	if projectPlatform_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Project Platform ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Project Platform ID not found")
 		return
 	}
 
@@ -57,8 +62,7 @@ func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 	exist_data, _ := projectPlatformTranslationRepo.ReadByLanguageIDProjectPlatformID(projectPlatformTranslationInput.LanguageID, projectPlatformTranslationInput.ProjectPlatformID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Project Platform Translation already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Project Platform Translation already added")
 		return
 	}
 
@@ -72,13 +76,12 @@ func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newProjectPlatformTranslation, err := projectPlatformTranslationRepo.Create(&newProjectPlatformTranslationData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new project platform translation"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new project platform translation")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newProjectPlatformTranslation.ID,
 			},
 		}
@@ -87,6 +90,76 @@ func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update project platform translation godoc
+// @Summary Update Project Platform Translation
+// @Description Update project platform translation, only provided fields are changed
+// @Tags project-platforms
+// @Accept json
+// @Produce json
+// @Param project_platform_id path int true "Project Platform ID"
+// @Param language_id path int true "Language ID"
+// @Param input body models.ProjectPlatformTranslationEditForm true "Project Platform translation input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /project-platform-translation/{project_platform_id}/{language_id} [put]
+// @Router /project-platform-translation/{project_platform_id}/{language_id} [patch]
+func UpdateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	projectPlatformIDStr, ok := vars["project_platform_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Project Platform ID not provided")
+		return
+	}
+
+	languageIDStr, ok := vars["language_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	var projectPlatformTranslationInput models.ProjectPlatformTranslationEditForm
+	if err := json.NewDecoder(r.Body).Decode(&projectPlatformTranslationInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode project platform translation input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(projectPlatformTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	projectPlatformTranslationRepo := repositories.NewProjectPlatformTranslationRepository()
+	projectPlatformID := helper.ParseIDStringToInt(projectPlatformIDStr)
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+
+	projectPlatformTranslation, err := projectPlatformTranslationRepo.ReadByLanguageIDProjectPlatformID(languageID, projectPlatformID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Project Platform Translation not found")
+		return
+	}
+
+	if projectPlatformTranslationInput.Title != nil {
+		projectPlatformTranslation.Title = *projectPlatformTranslationInput.Title
+	}
+	if projectPlatformTranslationInput.Description != nil {
+		projectPlatformTranslation.Description = *projectPlatformTranslationInput.Description
+	}
+
+	if _, err := projectPlatformTranslationRepo.Update(projectPlatformTranslation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update project platform translation")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user projectPlatform godoc
 // @Summary Delete User Project Platform
 // @Description Delete user project Platform
@@ -95,23 +168,21 @@ func CreateProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param project_platform_id path int true "Project Platform ID"
 // @Param language_id path int true "Language ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /project-platform-translation/{project_platform_id}/{language_id} [delete]
 func DeleteProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	projectPlatformIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": "Project ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Project ID not provided")
 		return
 	}
 
 	languageIDStr, ok := vars["language_id"]
 	if !ok {
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
 		return
 	}
 
@@ -121,13 +192,12 @@ func DeleteProjectPlatformTranslation(w http.ResponseWriter, r *http.Request) {
 
 	err := projectPlatformTranslationRepo.DeleteByLanguageIDProjectPlatformID(projectPlatformID, languageID)
 	if err != nil {
-		response := map[string]string{"message": "Project Platform ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Project Platform ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -0,0 +1,19 @@
+package public_handlers
+
+import (
+	"net/http"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+)
+
+// inactive (draft) rows only shown to the owner api key with read:drafts scope, nil mean no is_active filter
+func getIsActiveFilter(r *http.Request, userID int64) *bool {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err == nil && jwtClaim.Id == userID && helper.HasScope(r, models.APIKeyScopeReadDrafts) {
+		return nil
+	}
+
+	isActive := true
+	return &isActive
+}
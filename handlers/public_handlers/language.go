@@ -0,0 +1,68 @@
+package public_handlers
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+)
+
+var errLanguageNotFound = errors.New("language not found")
+
+// language fallback chain: requested language, user default language, application default language.
+// requested language come from language_id, then lang code, then Accept-Language header
+func resolveLanguageChain(r *http.Request, user *models.User) ([]int64, error) {
+	languageRepo := repositories.NewLanguageRepository()
+	var languageIDs []int64
+	appendLanguage := func(languageID int64) {
+		if languageID > 0 && !slices.Contains(languageIDs, languageID) {
+			languageIDs = append(languageIDs, languageID)
+		}
+	}
+
+	query := r.URL.Query()
+	switch {
+	case query.Get("language_id") != "":
+		language, err := languageRepo.Read(helper.ParseIDStringToInt(query.Get("language_id")))
+		if err != nil {
+			return nil, errLanguageNotFound
+		}
+		appendLanguage(language.ID)
+	case query.Get("lang") != "":
+		language, err := languageRepo.ReadByCode(strings.TrimSpace(query.Get("lang")))
+		if err != nil {
+			return nil, errLanguageNotFound
+		}
+		appendLanguage(language.ID)
+	default:
+		// first accepted tag which known, exact code then primary subtag
+		for _, tag := range helper.ParseAcceptLanguage(r.Header.Get("Accept-Language")) {
+			language, err := languageRepo.ReadByCode(tag)
+			if err != nil {
+				language, err = languageRepo.ReadByCode(helper.PrimaryLanguageSubtag(tag))
+			}
+			if err == nil {
+				appendLanguage(language.ID)
+				break
+			}
+		}
+	}
+
+	if user.DefaultLanguageID != nil {
+		appendLanguage(*user.DefaultLanguageID)
+	}
+
+	if language, err := languageRepo.ReadByCode(config.GetDefaultLanguageCode()); err == nil {
+		appendLanguage(language.ID)
+	}
+
+	if len(languageIDs) == 0 {
+		return nil, errLanguageNotFound
+	}
+	return languageIDs, nil
+}
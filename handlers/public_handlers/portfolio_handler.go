@@ -0,0 +1,125 @@
+package public_handlers
+
+import (
+	"net/http"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// max rows of every section
+	portfolioSectionSize = 100
+	// max section loaded at the same time, keep database connection pool free for other request
+	portfolioMaxConcurrency = 3
+)
+
+// get public user portfolio godoc
+// @Summary Get public User portfolio
+// @Description Get whole Public User portfolio (positions, attachments, languages, skills, experiences, educations, projects) in one call, every section return up to 100 rows
+// @Tags public-users
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Param language_id query string false "Language ID, take precedence over lang"
+// @Param lang query string false "Language code, e.g. en"
+// @Param Accept-Language header string false "Used when language_id and lang are empty"
+// @Param X-API-Key header string false "API key with read:drafts scope to include inactive rows of the key owner"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /public/user/{username}/portfolio [get]
+func GetPublicUserPortfolio(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userNameStr, ok := vars["username"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Username not provided")
+		return
+	}
+
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.ReadByUsername(userNameStr)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Username not found")
+		return
+	}
+
+	languageIDs, err := resolveLanguageChain(r, user)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language not found")
+		return
+	}
+
+	isActive := getIsActiveFilter(r, user.ID)
+
+	// every section read first page only
+	sectionPage := helper.Pagination{Size: portfolioSectionSize, Number: 1}
+
+	var (
+		userPositions   []models.UserPosition
+		userAttachments []models.UserAttachment
+		userLanguages   []models.UserLanguageTranslationResponse
+		userSkills      []models.SkillTranslationResponse
+		userExperiences []models.ExperienceTranslationResponse
+		userEducations  []models.EducationTranslationResponse
+		userProjects    []models.ProjectTranslationResponse
+	)
+
+	// every task write to its own variable
+	err = helper.RunConcurrently(portfolioMaxConcurrency,
+		func() (err error) {
+			userPositions, err = repositories.NewUserPositionRepository().ReadAll(&user.ID, isActive)
+			return err
+		},
+		func() (err error) {
+			userAttachments, err = repositories.NewUserAttachmentRepository().ReadAll(&user.ID, nil, isActive)
+			return err
+		},
+		func() (err error) {
+			userLanguages, err = repositories.NewUserLanguageRepository().ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, sectionPage)
+			return err
+		},
+		func() (err error) {
+			userSkills, err = repositories.NewUserSkillRepository().ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, sectionPage)
+			return err
+		},
+		func() (err error) {
+			userExperiences, err = repositories.NewUserExperienceRepository().ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, sectionPage)
+			return err
+		},
+		func() (err error) {
+			userEducations, err = repositories.NewUserEducationRepository().ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, sectionPage)
+			return err
+		},
+		func() (err error) {
+			userProjects, err = repositories.NewUserProjectRepository().ReadTranslationsByUserIDLanguageID(user.ID, nil, languageIDs, isActive, sectionPage)
+			return err
+		},
+	)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to fetch user portfolio")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"id":          user.ID,
+			"username":    user.Username,
+			"name":        user.Name,
+			"created_at":  user.CreatedAt,
+			"updated_at":  user.UpdatedAt,
+			"positions":   toPositionResponses(userPositions),
+			"attachments": toAttachmentResponses(userAttachments),
+			"languages":   toLanguageResponses(userLanguages, languageIDs[0]),
+			"skills":      toSkillResponses(userSkills, languageIDs[0]),
+			"experiences": toExperienceResponses(userExperiences, languageIDs[0]),
+			"educations":  toEducationResponses(userEducations, languageIDs[0]),
+			"projects":    toProjectResponses(userProjects, languageIDs[0]),
+		},
+	}
+
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,177 @@
+package public_handlers
+
+import (
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
+)
+
+// response shape shared by single section endpoint and portfolio endpoint, nil when there is no row.
+// requestedLanguageID is first language of fallback chain, other language is marked as fallback
+
+func toPositionResponses(userPositions []models.UserPosition) []map[string]interface{} {
+	var positions []map[string]interface{}
+	for _, userPosition := range userPositions {
+		position := map[string]interface{}{
+			"id":    userPosition.ID,
+			"title": userPosition.Title,
+		}
+		positions = append(positions, position)
+	}
+	return positions
+}
+
+func toAttachmentResponses(userAttachments []models.UserAttachment) []map[string]interface{} {
+	var attachments []map[string]interface{}
+	for _, userAttachment := range userAttachments {
+		attachment := map[string]interface{}{
+			"id":                    userAttachment.ID,
+			"title":                 userAttachment.Title,
+			"category":              userAttachment.Category,
+			"image_url":             storages.GetPublicURL(userAttachment.ImageUrl),
+			"image_srcset":          storages.GetImageSrcset(userAttachment.ImageUrl),
+			"url":                   userAttachment.Url,
+			"is_external_url":       userAttachment.IsExternalUrl,
+			"is_external_image_url": userAttachment.IsExternalImageUrl,
+		}
+		attachments = append(attachments, attachment)
+	}
+	return attachments
+}
+
+func toLanguageResponses(userLanguages []models.UserLanguageTranslationResponse, requestedLanguageID int64) []map[string]interface{} {
+	var languages []map[string]interface{}
+	for _, userLanguage := range userLanguages {
+		language := map[string]interface{}{
+			"id":          userLanguage.ID,
+			"code":        userLanguage.Code,
+			"title":       userLanguage.Title,
+			"name":        userLanguage.Name,
+			"description": userLanguage.Description,
+			"logo_url":    storages.GetPublicURL(userLanguage.LogoUrl),
+			"logo_srcset": storages.GetImageSrcset(userLanguage.LogoUrl),
+			"fallback":    userLanguage.LanguageID != requestedLanguageID,
+		}
+		languages = append(languages, language)
+	}
+	return languages
+}
+
+func toSkillResponses(userSkills []models.SkillTranslationResponse, requestedLanguageID int64) []models.SkillTranslationResponse {
+	var filteredUserSkills []models.SkillTranslationResponse
+	for _, userSkill := range userSkills {
+
+		fullImageURL := storages.GetPublicURL(userSkill.ImageUrl)
+
+		filteredUserSkills = append(filteredUserSkills, models.SkillTranslationResponse{
+			ID:                 userSkill.ID,
+			LanguageID:         userSkill.LanguageID,
+			Code:               userSkill.Code,
+			Name:               userSkill.Name,
+			ImageUrl:           fullImageURL,
+			ImageSrcset:        storages.GetImageSrcset(userSkill.ImageUrl),
+			Url:                userSkill.Url,
+			IsExternalUrl:      userSkill.IsExternalUrl,
+			IsExternalImageUrl: userSkill.IsExternalImageUrl,
+			Description:        userSkill.Description,
+			CreatedAt:          userSkill.CreatedAt,
+			UpdatedAt:          userSkill.UpdatedAt,
+			Fallback:           userSkill.LanguageID != requestedLanguageID,
+		})
+	}
+	return filteredUserSkills
+}
+
+func toExperienceResponses(userExperiences []models.ExperienceTranslationResponse, requestedLanguageID int64) []models.ExperienceTranslationResponse {
+	var filteredUserExperiences []models.ExperienceTranslationResponse
+	for _, userExperience := range userExperiences {
+
+		fullImageURL := storages.GetPublicURL(userExperience.CompanyImageUrl)
+
+		filteredUserExperiences = append(filteredUserExperiences, models.ExperienceTranslationResponse{
+			ID:                        userExperience.ID,
+			LanguageID:                userExperience.LanguageID,
+			CompanyID:                 userExperience.CompanyID,
+			Title:                     userExperience.Title,
+			Description:               userExperience.Description,
+			Category:                  userExperience.Category,
+			Location:                  userExperience.Location,
+			LocationType:              userExperience.LocationType,
+			Industry:                  userExperience.Industry,
+			MonthStart:                userExperience.MonthStart,
+			MonthEnd:                  userExperience.MonthEnd,
+			YearStart:                 userExperience.YearStart,
+			YearEnd:                   userExperience.YearEnd,
+			CompanyCode:               userExperience.CompanyCode,
+			CompanyName:               userExperience.CompanyName,
+			CompanyImageUrl:           fullImageURL,
+			CompanyImageSrcset:        storages.GetImageSrcset(userExperience.CompanyImageUrl),
+			CompanyUrl:                userExperience.CompanyUrl,
+			CompanyIsExternalUrl:      userExperience.CompanyIsExternalUrl,
+			CompanyIsExternalImageUrl: userExperience.CompanyIsExternalImageUrl,
+			CreatedAt:                 userExperience.CreatedAt,
+			UpdatedAt:                 userExperience.UpdatedAt,
+			Fallback:                  userExperience.LanguageID != requestedLanguageID,
+		})
+	}
+	return filteredUserExperiences
+}
+
+func toEducationResponses(userEducations []models.EducationTranslationResponse, requestedLanguageID int64) []models.EducationTranslationResponse {
+	var filteredUserEducations []models.EducationTranslationResponse
+	for _, userEducation := range userEducations {
+
+		fullImageURL := storages.GetPublicURL(userEducation.SchoolImageUrl)
+
+		filteredUserEducations = append(filteredUserEducations, models.EducationTranslationResponse{
+			ID:                       userEducation.ID,
+			LanguageID:               userEducation.LanguageID,
+			SchoolID:                 userEducation.SchoolID,
+			Title:                    userEducation.Title,
+			Description:              userEducation.Description,
+			Category:                 userEducation.Category,
+			Location:                 userEducation.Location,
+			LocationType:             userEducation.LocationType,
+			MonthStart:               userEducation.MonthStart,
+			MonthEnd:                 userEducation.MonthEnd,
+			YearStart:                userEducation.YearStart,
+			YearEnd:                  userEducation.YearEnd,
+			SchoolCode:               userEducation.SchoolCode,
+			SchoolName:               userEducation.SchoolName,
+			SchoolImageUrl:           fullImageURL,
+			SchoolImageSrcset:        storages.GetImageSrcset(userEducation.SchoolImageUrl),
+			SchoolUrl:                userEducation.SchoolUrl,
+			SchoolIsExternalUrl:      userEducation.SchoolIsExternalUrl,
+			SchoolIsExternalImageUrl: userEducation.SchoolIsExternalImageUrl,
+			CreatedAt:                userEducation.CreatedAt,
+			UpdatedAt:                userEducation.UpdatedAt,
+			Fallback:                 userEducation.LanguageID != requestedLanguageID,
+		})
+	}
+	return filteredUserEducations
+}
+
+func toProjectResponses(userProjects []models.ProjectTranslationResponse, requestedLanguageID int64) []models.ProjectTranslationResponse {
+	var filteredUserProjects []models.ProjectTranslationResponse
+	for _, userProject := range userProjects {
+
+		fullImageURL := storages.GetPublicURL(userProject.ImageUrl)
+
+		filteredUserProjects = append(filteredUserProjects, models.ProjectTranslationResponse{
+			ID:                userProject.ID,
+			LanguageID:        userProject.LanguageID,
+			ProjectPlatformID: userProject.ProjectPlatformID,
+			Name:              userProject.Name,
+			Slug:              userProject.Slug,
+			Description:       userProject.Description,
+			ImageUrl:          fullImageURL,
+			ImageSrcset:       storages.GetImageSrcset(userProject.ImageUrl),
+			ProjectCreatedAt:  userProject.ProjectCreatedAt,
+			ProjectUpdatedAt:  userProject.ProjectUpdatedAt,
+			CreatedAt:         userProject.CreatedAt,
+			UpdatedAt:         userProject.UpdatedAt,
+			MachineTranslated: userProject.MachineTranslated,
+			Fallback:          userProject.LanguageID != requestedLanguageID,
+		})
+	}
+	return filteredUserProjects
+}
@@ -0,0 +1,168 @@
+package public_handlers
+
+import (
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/search"
+	"github.com/gorilla/mux"
+)
+
+const (
+	// max rows of every section loaded into search index
+	searchSectionSize = 1000
+	searchMaxSize     = 50
+	searchMinQuery    = 2
+)
+
+const (
+	searchTypeProject    = "project"
+	searchTypeExperience = "experience"
+	searchTypeSkill      = "skill"
+)
+
+// search public user portfolio godoc
+// @Summary Search public User portfolio
+// @Description Search projects (name, description), experiences (title, description, industry, company name) and skills (name, description) of Public User. Results are ranked, typed, and have html escaped snippet with matched words wrapped in <mark>
+// @Tags public-users
+// @Accept json
+// @Produce json
+// @Param username path string true "Username"
+// @Param q query string true "Search query, at least 2 characters"
+// @Param size query int false "Max results, at most 50" default(5)
+// @Param language_id query string false "Language ID, take precedence over lang"
+// @Param lang query string false "Language code, e.g. en"
+// @Param Accept-Language header string false "Used when language_id and lang are empty"
+// @Param X-API-Key header string false "API key with read:drafts scope to include inactive rows of the key owner"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /public/user/{username}/search [get]
+func SearchPublicUserPortfolio(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userNameStr, ok := vars["username"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Username not provided")
+		return
+	}
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if utf8.RuneCountInString(query) < searchMinQuery {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Search query must be at least 2 characters")
+		return
+	}
+
+	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
+	if pageSize > searchMaxSize {
+		pageSize = searchMaxSize
+	}
+
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.ReadByUsername(userNameStr)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Username not found")
+		return
+	}
+
+	languageIDs, err := resolveLanguageChain(r, user)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language not found")
+		return
+	}
+
+	isActive := getIsActiveFilter(r, user.ID)
+
+	// every section read first page only
+	sectionPage := helper.Pagination{Size: searchSectionSize, Number: 1}
+
+	var (
+		userProjects    []models.ProjectTranslationResponse
+		userExperiences []models.ExperienceTranslationResponse
+		userSkills      []models.SkillTranslationResponse
+	)
+	err = helper.RunConcurrently(portfolioMaxConcurrency,
+		func() (err error) {
+			userProjects, err = repositories.NewUserProjectRepository().ReadTranslationsByUserIDLanguageID(user.ID, nil, languageIDs, isActive, sectionPage)
+			return err
+		},
+		func() (err error) {
+			userExperiences, err = repositories.NewUserExperienceRepository().ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, sectionPage)
+			return err
+		},
+		func() (err error) {
+			userSkills, err = repositories.NewUserSkillRepository().ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, sectionPage)
+			return err
+		},
+	)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to fetch user portfolio")
+		return
+	}
+
+	index := search.NewInvertedIndex()
+	index.Add(toSearchDocuments(userProjects, userExperiences, userSkills, languageIDs[0])...)
+
+	response := helper.Response{
+		Message: "success",
+		Data:    index.Search(query, pageSize),
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// title like field weight more than description
+func toSearchDocuments(userProjects []models.ProjectTranslationResponse, userExperiences []models.ExperienceTranslationResponse, userSkills []models.SkillTranslationResponse, requestedLanguageID int64) []search.Document {
+	var documents []search.Document
+	for _, userProject := range userProjects {
+		documents = append(documents, search.Document{
+			Type:  searchTypeProject,
+			ID:    userProject.ID,
+			Title: userProject.Name,
+			Fields: []search.Field{
+				{Name: "name", Text: userProject.Name, Weight: 3},
+				{Name: "description", Text: userProject.Description, Weight: 1},
+			},
+			Extra: map[string]interface{}{
+				"slug":     userProject.Slug,
+				"fallback": userProject.LanguageID != requestedLanguageID,
+			},
+		})
+	}
+
+	for _, userExperience := range userExperiences {
+		documents = append(documents, search.Document{
+			Type:  searchTypeExperience,
+			ID:    userExperience.ID,
+			Title: userExperience.Title,
+			Fields: []search.Field{
+				{Name: "title", Text: userExperience.Title, Weight: 3},
+				{Name: "company_name", Text: userExperience.CompanyName, Weight: 2},
+				{Name: "industry", Text: userExperience.Industry, Weight: 2},
+				{Name: "description", Text: userExperience.Description, Weight: 1},
+			},
+			Extra: map[string]interface{}{
+				"fallback": userExperience.LanguageID != requestedLanguageID,
+			},
+		})
+	}
+
+	for _, userSkill := range userSkills {
+		documents = append(documents, search.Document{
+			Type:  searchTypeSkill,
+			ID:    userSkill.ID,
+			Title: userSkill.Name,
+			Fields: []search.Field{
+				{Name: "name", Text: userSkill.Name, Weight: 3},
+				{Name: "description", Text: userSkill.Description, Weight: 1},
+			},
+			Extra: map[string]interface{}{
+				"fallback": userSkill.LanguageID != requestedLanguageID,
+			},
+		})
+	}
+	return documents
+}
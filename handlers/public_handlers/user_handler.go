@@ -17,28 +17,25 @@ import (
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
 // @Param username path string true "Username"
-// @Param language_id query string true "Filter by languageId"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param language_id query string false "Language ID, take precedence over lang"
+// @Param lang query string false "Language code, e.g. en"
+// @Param Accept-Language header string false "Used when language_id and lang are empty"
+// @Param X-API-Key header string false "API key with read:drafts scope to include inactive rows of the key owner"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /public/user/{username} [get]
 func GetPublicFilteredPaginatedUserDetail(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userNameStr, ok := vars["username"]
 	if !ok {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Username not provided")
 		return
 	}
-
-	languageIDFilterStr := r.URL.Query().Get("language_id")
-	languageIDFilter := helper.ParseIDStringToInt(languageIDFilterStr)
 	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
 	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
 
-	isActiveBool := helper.ParseIDStringToBool("true")
-
 	userRepo := repositories.NewUserRepository()
 	userAttachmentRepo := repositories.NewUserAttachmentRepository()
 	userPositionRepo := repositories.NewUserPositionRepository()
@@ -46,72 +43,43 @@ func GetPublicFilteredPaginatedUserDetail(w http.ResponseWriter, r *http.Request
 
 	user, err := userRepo.ReadByUsername(userNameStr)
 	if err != nil {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Username not found")
 		return
 	}
 
-	userPositions, err := userPositionRepo.ReadAll(&user.ID, &isActiveBool)
+	languageIDs, err := resolveLanguageChain(r, user)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch user positions"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language not found")
 		return
 	}
 
-	userAttachments, err := userAttachmentRepo.ReadAll(&user.ID, nil, &isActiveBool)
+	isActive := getIsActiveFilter(r, user.ID)
+
+	userPositions, err := userPositionRepo.ReadAll(&user.ID, isActive)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch user attachment"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch user positions")
 		return
 	}
 
-	userLanguages, err := userLanguageRepo.ReadTranslationsByUserIDLanguageID(user.ID, languageIDFilter, &isActiveBool, pageNumber, pageSize)
+	userAttachments, err := userAttachmentRepo.ReadAll(&user.ID, nil, isActive)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch user language"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch user attachment")
 		return
 	}
 
-	var positions []map[string]interface{}
-	var attachments []map[string]interface{}
-	var languages []map[string]interface{}
-
-	for _, userPosition := range userPositions {
-		position := map[string]interface{}{
-			"id":    userPosition.ID,
-			"title": userPosition.Title,
-		}
-		positions = append(positions, position)
-	}
-
-	for _, userAttachment := range userAttachments {
-		attachment := map[string]interface{}{
-			"id":                    userAttachment.ID,
-			"title":                 userAttachment.Title,
-			"category":              userAttachment.Category,
-			"image_url":             helper.GetFullImageUrl(userAttachment.ImageUrl, r),
-			"url":                   userAttachment.Url,
-			"is_external_url":       userAttachment.IsExternalUrl,
-			"is_external_image_url": userAttachment.IsExternalImageUrl,
-		}
-		attachments = append(attachments, attachment)
+	userLanguages, err := userLanguageRepo.ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, helper.Pagination{Size: pageSize, Number: pageNumber})
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch user language")
+		return
 	}
 
-	for _, userLanguage := range userLanguages {
-		language := map[string]interface{}{
-			"id":          userLanguage.ID,
-			"code":        userLanguage.Code,
-			"title":       userLanguage.Title,
-			"name":        userLanguage.Name,
-			"description": userLanguage.Description,
-			"logo_url":    helper.GetFullImageUrl(userLanguage.LogoUrl, r),
-		}
-		languages = append(languages, language)
-	}
+	positions := toPositionResponses(userPositions)
+	attachments := toAttachmentResponses(userAttachments)
+	languages := toLanguageResponses(userLanguages, languageIDs[0])
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
 			"id":          user.ID,
 			"username":    user.Username,
 			"name":        user.Name,
@@ -1,12 +1,11 @@
 package public_handlers
 
 import (
-	"math"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
-	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -18,31 +17,32 @@ import (
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
 // @Param project_platform_id query string false "Project Platform ID"
 // @Param username path string true "Username"
-// @Param language_id query string true "Filter by languageId"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param language_id query string false "Language ID, take precedence over lang"
+// @Param lang query string false "Language code, e.g. en"
+// @Param Accept-Language header string false "Used when language_id and lang are empty"
+// @Param X-API-Key header string false "API key with read:drafts scope to include inactive rows of the key owner"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /public/user/{username}/project [get]
 func GetPublicFilteredPaginatedUserProjectDetail(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userNameStr, ok := vars["username"]
 	if !ok {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Username not provided")
 		return
 	}
 
-	languageIDFilterStr := r.URL.Query().Get("language_id")
 	projectPlatformIDFilterStr := r.URL.Query().Get("project_platform_id")
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	isActiveBool := helper.ParseIDStringToBool("true")
-
-	languageIDFilter := helper.ParseIDStringToInt(languageIDFilterStr)
+	pagination, err := helper.ParsePagination(r, "id", helper.SortOrderAsc)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
+		return
+	}
 
 	var projectPlatformIDFilter *int64
 	parsedID := helper.ParseIDStringToInt(projectPlatformIDFilterStr)
@@ -55,63 +55,54 @@ func GetPublicFilteredPaginatedUserProjectDetail(w http.ResponseWriter, r *http.
 
 	user, err := userRepo.ReadByUsername(userNameStr)
 	if err != nil {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Username not found")
 		return
 	}
 
-	totalCount, err := userProjectRepo.Count(&user.ID, projectPlatformIDFilter, &isActiveBool)
+	languageIDs, err := resolveLanguageChain(r, user)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language not found")
 		return
 	}
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	isActive := getIsActiveFilter(r, user.ID)
 
-	userProjects, err := userProjectRepo.ReadTranslationsByUserIDLanguageID(user.ID, projectPlatformIDFilter, languageIDFilter, &isActiveBool, pageNumber, pageSize)
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = userProjectRepo.Count(&user.ID, projectPlatformIDFilter, isActive)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
+
+	userProjects, err := userProjectRepo.ReadTranslationsByUserIDLanguageID(user.ID, projectPlatformIDFilter, languageIDs, isActive, pagination)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch users project"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch users project")
 		return
 	}
 
-	var filteredUserProjects []models.ProjectTranslationResponse
-
-	for _, userProject := range userProjects {
-
-		fullImageURL := helper.GetFullImageUrl(userProject.ImageUrl, r)
-
-		filteredUserProjects = append(filteredUserProjects, models.ProjectTranslationResponse{
-			ID:                userProject.ID,
-			LanguageID:        userProject.LanguageID,
-			ProjectPlatformID: userProject.ProjectPlatformID,
-			Name:              userProject.Name,
-			Slug:              userProject.Slug,
-			Description:       userProject.Description,
-			ImageUrl:          fullImageURL,
-			ProjectCreatedAt:  userProject.ProjectCreatedAt,
-			ProjectUpdatedAt:  userProject.ProjectUpdatedAt,
-			CreatedAt:         userProject.CreatedAt,
-			UpdatedAt:         userProject.UpdatedAt,
-		})
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		userProjects, nextCursor, prevCursor, err = helper.BuildCursorPage(userProjects, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
 	}
 
+	filteredUserProjects := toProjectResponses(userProjects, languageIDs[0])
+
 	if filteredUserProjects == nil {
-		response := map[string]string{"message": "user project not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "user project not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredUserProjects,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredUserProjects,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -125,52 +116,53 @@ func GetPublicFilteredPaginatedUserProjectDetail(w http.ResponseWriter, r *http.
 // @Produce json
 // @Param username path string true "Username"
 // @Param slug path string true "Slug"
-// @Param language_id query string true "Filter by languageId"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param language_id query string false "Language ID, take precedence over lang"
+// @Param lang query string false "Language code, e.g. en"
+// @Param Accept-Language header string false "Used when language_id and lang are empty"
+// @Param X-API-Key header string false "API key with read:drafts scope to include inactive rows of the key owner"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /public/user/{username}/project/{slug} [get]
 func GetPublicProjectDetail(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userNameStr, ok := vars["username"]
 	if !ok {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Username not provided")
 		return
 	}
 	slugStr, okSlug := vars["slug"]
 	if !okSlug {
-		response := map[string]string{"message": "Slug not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Slug not provided")
 		return
 	}
 
-	languageIDFilterStr := r.URL.Query().Get("language_id")
-	languageIDFilter := helper.ParseIDStringToInt(languageIDFilterStr)
-
 	userRepo := repositories.NewUserRepository()
 	userProjectRepo := repositories.NewUserProjectRepository()
 	userProjectAttachmentRepo := repositories.NewUserProjectAttachmentRepository()
 
 	user, err := userRepo.ReadByUsername(userNameStr)
 	if err != nil {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Username not found")
+		return
+	}
+
+	languageIDs, err := resolveLanguageChain(r, user)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language not found")
 		return
 	}
 
-	userProject, err := userProjectRepo.ReadByUserIDSlugLanguageID(user.ID, slugStr, languageIDFilter)
+	userProject, err := userProjectRepo.ReadByUserIDSlugLanguageID(user.ID, slugStr, languageIDs, getIsActiveFilter(r, user.ID))
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch users project"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch users project")
 		return
 	}
 
 	userProjectAttachments, err := userProjectAttachmentRepo.ReadAll(&userProject.ID)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch user positions"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch user positions")
 		return
 	}
 
@@ -181,7 +173,8 @@ func GetPublicProjectDetail(w http.ResponseWriter, r *http.Request) {
 			"id":                    userProjectAttachment.ID,
 			"title":                 userProjectAttachment.Title,
 			"category":              userProjectAttachment.Category,
-			"image_url":             helper.GetFullImageUrl(userProjectAttachment.ImageUrl, r),
+			"image_url":             storages.GetPublicURL(userProjectAttachment.ImageUrl),
+			"image_srcset":          storages.GetImageSrcset(userProjectAttachment.ImageUrl),
 			"url":                   userProjectAttachment.Url,
 			"is_external_url":       userProjectAttachment.IsExternalUrl,
 			"is_external_image_url": userProjectAttachment.IsExternalImageUrl,
@@ -189,14 +182,18 @@ func GetPublicProjectDetail(w http.ResponseWriter, r *http.Request) {
 		projectAttachments = append(projectAttachments, projectAttachment)
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
 			"id":                  userProject.ID,
+			"language_id":         userProject.LanguageID,
+			"fallback":            userProject.LanguageID != languageIDs[0],
+			"machine_translated":  userProject.MachineTranslated,
 			"project_platform_id": userProject.ProjectPlatformID,
 			"name":                userProject.Name,
 			"slug":                userProject.Slug,
-			"image_url":           helper.GetFullImageUrl(userProject.ImageUrl, r),
+			"image_url":           storages.GetPublicURL(userProject.ImageUrl),
+			"image_srcset":        storages.GetImageSrcset(userProject.ImageUrl),
 			"description":         userProject.Description,
 			"project_created_at":  userProject.ProjectCreatedAt,
 			"project_updated_at":  userProject.ProjectUpdatedAt,
@@ -1,11 +1,9 @@
 package public_handlers
 
 import (
-	"math"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
-	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
 	"github.com/gorilla/mux"
 )
@@ -18,92 +16,84 @@ import (
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
 // @Param username path string true "Username"
-// @Param language_id query string true "Filter by languageId"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param language_id query string false "Language ID, take precedence over lang"
+// @Param lang query string false "Language code, e.g. en"
+// @Param Accept-Language header string false "Used when language_id and lang are empty"
+// @Param X-API-Key header string false "API key with read:drafts scope to include inactive rows of the key owner"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /public/user/{username}/skill [get]
 func GetPublicFilteredPaginatedUserSkillDetail(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userNameStr, ok := vars["username"]
 	if !ok {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Username not provided")
 		return
 	}
 
-	languageIDFilterStr := r.URL.Query().Get("language_id")
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	isActiveBool := helper.ParseIDStringToBool("true")
-
-	languageIDFilter := helper.ParseIDStringToInt(languageIDFilterStr)
+	pagination, err := helper.ParsePagination(r, "id", helper.SortOrderAsc)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
+		return
+	}
 
 	userRepo := repositories.NewUserRepository()
 	userSkillRepo := repositories.NewUserSkillRepository()
 
 	user, err := userRepo.ReadByUsername(userNameStr)
 	if err != nil {
-		response := map[string]string{"message": "Username not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Username not found")
 		return
 	}
 
-	totalCount, err := userSkillRepo.Count(&user.ID, &isActiveBool)
+	languageIDs, err := resolveLanguageChain(r, user)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language not found")
 		return
 	}
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	isActive := getIsActiveFilter(r, user.ID)
 
-	userSkills, err := userSkillRepo.ReadTranslationsByUserIDLanguageID(user.ID, languageIDFilter, &isActiveBool, pageNumber, pageSize)
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = userSkillRepo.Count(&user.ID, isActive)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
+
+	userSkills, err := userSkillRepo.ReadTranslationsByUserIDLanguageID(user.ID, languageIDs, isActive, pagination)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch users skill"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch users skill")
 		return
 	}
 
-	var filteredUserSkills []models.SkillTranslationResponse
-
-	for _, userSkill := range userSkills {
-
-		fullImageURL := helper.GetFullImageUrl(userSkill.ImageUrl, r)
-
-		filteredUserSkills = append(filteredUserSkills, models.SkillTranslationResponse{
-			ID:                 userSkill.ID,
-			LanguageID:         userSkill.LanguageID,
-			Code:               userSkill.Code,
-			Name:               userSkill.Name,
-			ImageUrl:           fullImageURL,
-			Url:                userSkill.Url,
-			IsExternalUrl:      userSkill.IsExternalUrl,
-			IsExternalImageUrl: userSkill.IsExternalImageUrl,
-			Description:        userSkill.Description,
-			CreatedAt:          userSkill.CreatedAt,
-			UpdatedAt:          userSkill.UpdatedAt,
-		})
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		userSkills, nextCursor, prevCursor, err = helper.BuildCursorPage(userSkills, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
 	}
 
+	filteredUserSkills := toSkillResponses(userSkills, languageIDs[0])
+
 	if filteredUserSkills == nil {
-		response := map[string]string{"message": "user skill not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "user skill not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredUserSkills,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredUserSkills,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -1,14 +1,14 @@
 package handlers
 
 import (
-	"math"
+	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -25,15 +25,16 @@ import (
 // @Param is_external_url formData bool false "Is external URL"
 // @Param is_external_image_url formData bool false "Is external image URL"
 // @Param address formData string false "School address"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /school [post]
 func CreateSchool(w http.ResponseWriter, r *http.Request) {
 	err := r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
@@ -43,60 +44,65 @@ func CreateSchool(w http.ResponseWriter, r *http.Request) {
 	address := r.FormValue("address")
 	isExternalUrl := helper.ParseIDStringToBool(r.FormValue("is_external_url"))
 	isExternalImageUrl := helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+
+	// Create a new school record
+	newSchool := models.School{
+		Code:               code,
+		Name:               name,
+		Url:                url,
+		Address:            address,
+		IsExternalUrl:      isExternalUrl,
+		IsExternalImageUrl: isExternalImageUrl,
+	}
+	if fieldErrors := helper.ValidateStruct(newSchool); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("image_file")
+	file, _, err := r.FormFile("image_file")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	schoolRepo := repositories.NewSchoolRepository()
 	// validation name and code unique
-	exist_school, _ := schoolRepo.ReadByNameOrCode(name, code)
-	if exist_school != nil {
-		// Handle error
-		response := map[string]string{"message": "Name or Code already used"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+	exist, err := schoolRepo.ExistsByNameOrCode(name, code, 0)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
 		return
 	}
-
-	// validation location image
-	var directory = "./assets/images/school"
-	if err := os.MkdirAll(directory, 0755); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
 		return
 	}
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	var finalFilename = code + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
 
-	// Create a new school record
-	newSchool := models.School{
-		Code:               code,
-		Name:               name,
-		ImageUrl:           imageUrl,
-		Url:                url,
-		Address:            address,
-		IsExternalUrl:      isExternalUrl,
-		IsExternalImageUrl: isExternalImageUrl,
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectorySchool)
+	if !ok {
+		return
 	}
+	newSchool.ImageUrl = imageUrl
+
 	// insert to database
-	if newSchool, err := schoolRepo.Create(&newSchool); err != nil {
+	if newSchool, err := unitOfWork.SchoolRepository().Create(&newSchool); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new school"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new school")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new school")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newSchool.ID,
 			},
 		}
@@ -113,32 +119,51 @@ func CreateSchool(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
+// @Param q query string false "Search code or name"
+// @Param sort query string false "Sort column" Enums(id, code, name, created_at, updated_at) default(id)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param created_from query string false "Created at from, YYYY-MM-DD or RFC3339"
+// @Param created_to query string false "Created at to (inclusive), YYYY-MM-DD or RFC3339"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /school [get]
 func GetFilteredPaginatedSchools(w http.ResponseWriter, r *http.Request) {
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	schoolRepo := repositories.NewSchoolRepository()
-	totalCount, err := schoolRepo.Count()
+	listQuery, err := helper.ParseListQuery(r, repositories.MasterDataSortColumns)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
 		return
 	}
+	pagination := listQuery.Pagination
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	schoolRepo := repositories.NewSchoolRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = schoolRepo.Count(listQuery)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
 
-	schools, err := schoolRepo.ReadFilteredPaginated(pageSize, pageNumber)
+	schools, err := schoolRepo.ReadFilteredPaginated(listQuery)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch schools"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch schools")
 		return
 	}
 
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		schools, nextCursor, prevCursor, err = helper.BuildCursorPage(schools, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
+	}
+
 	var filteredSchools []models.SchoolAllResponse
 	for _, school := range schools {
 		filteredSchools = append(filteredSchools, models.SchoolAllResponse{
@@ -151,20 +176,14 @@ func GetFilteredPaginatedSchools(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if filteredSchools == nil {
-		response := map[string]string{"message": "schools not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "schools not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredSchools,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredSchools,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -177,16 +196,16 @@ func GetFilteredPaginatedSchools(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "School ID"
-// @Success 200 {object} map[string]string "Success"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /school/{id} [get]
 func ReadSchool(w http.ResponseWriter, r *http.Request) {
 	// Extract school ID from the request URL
 	vars := mux.Vars(r)
 	schoolIDStr, ok := vars["id"]
 	if !ok {
-		http.Error(w, "School ID not provided", http.StatusBadRequest)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not provided")
 		return
 	}
 
@@ -195,16 +214,15 @@ func ReadSchool(w http.ResponseWriter, r *http.Request) {
 	schoolRepo := repositories.NewSchoolRepository()
 	school, err := schoolRepo.Read(schoolID)
 	if err != nil {
-		response := map[string]string{"message": "School ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "School ID not found")
 		return
 	}
 
-	fullImageURL := helper.GetFullImageUrl(school.ImageUrl, r)
+	fullImageURL := storages.GetPublicURL(school.ImageUrl)
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
 			"id":                    school.ID,
 			"code":                  school.Code,
 			"name":                  school.Name,
@@ -220,3 +238,183 @@ func ReadSchool(w http.ResponseWriter, r *http.Request) {
 
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
+
+// UpdateSchool godoc
+// @Summary Update school
+// @Description Update school with optional file replacement, only provided fields are changed
+// @Tags schools
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "School ID"
+// @Param code formData string false "School code"
+// @Param name formData string false "School name"
+// @Param url formData string false "School URL"
+// @Param address formData string false "School address"
+// @Param is_external_url formData bool false "Is external URL"
+// @Param is_external_image_url formData bool false "Is external image URL"
+// @Param image_file formData file false "School image file"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /school/{id} [patch]
+func UpdateSchool(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	schoolIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not provided")
+		return
+	}
+
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
+		return
+	}
+
+	schoolRepo := repositories.NewSchoolRepository()
+	schoolID := helper.ParseIDStringToInt(schoolIDStr)
+	school, err := schoolRepo.Read(schoolID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "School ID not found")
+		return
+	}
+
+	if r.PostForm.Has("code") {
+		school.Code = r.FormValue("code")
+	}
+	if r.PostForm.Has("name") {
+		school.Name = r.FormValue("name")
+	}
+
+	// validation name and code unique in other school
+	exist, err := schoolRepo.ExistsByNameOrCode(school.Name, school.Code, school.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
+		return
+	}
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
+		return
+	}
+
+	if r.PostForm.Has("url") {
+		school.Url = r.FormValue("url")
+	}
+	if r.PostForm.Has("address") {
+		school.Address = r.FormValue("address")
+	}
+	if r.PostForm.Has("is_external_url") {
+		school.IsExternalUrl = helper.ParseIDStringToBool(r.FormValue("is_external_url"))
+	}
+	if r.PostForm.Has("is_external_image_url") {
+		school.IsExternalImageUrl = helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+	}
+
+	if fieldErrors := helper.ValidateStruct(school); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := school.ImageUrl
+	file, _, err := r.FormFile("image_file")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectorySchool)
+		if !ok {
+			return
+		}
+		school.ImageUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.SchoolRepository().Update(school); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update school")
+		return
+	}
+
+	if storages.KeyFromPath(school.ImageUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update school")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// DeleteSchool godoc
+// @Summary Delete school
+// @Description Delete school, refused while user education rows still reference it unless force is true
+// @Tags schools
+// @Accept json
+// @Produce json
+// @Param id path int true "School ID"
+// @Param force query bool false "Delete together with referencing rows"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /school/{id} [delete]
+func DeleteSchool(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	schoolIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not provided")
+		return
+	}
+
+	force := helper.ParseIDStringToBool(r.URL.Query().Get("force"))
+
+	schoolRepo := repositories.NewSchoolRepository()
+	schoolID := helper.ParseIDStringToInt(schoolIDStr)
+	school, err := schoolRepo.Read(schoolID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "School ID not found")
+		return
+	}
+
+	// the foreign keys cascade, so deleting a used school also deletes user data
+	totalReference, err := schoolRepo.CountReferences(schoolID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch total reference")
+		return
+	}
+
+	if totalReference > 0 && !force {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, fmt.Sprintf("School still used by %d user education rows, use force=true to delete", totalReference))
+		return
+	}
+
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	if err := unitOfWork.SchoolRepository().Delete(schoolID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete school")
+		return
+	}
+
+	storages.DeleteImage(unitOfWork.Files, school.ImageUrl)
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete school")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
@@ -1,14 +1,14 @@
 package handlers
 
 import (
-	"math"
+	"fmt"
 	"net/http"
-	"os"
-	"path/filepath"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -24,15 +24,16 @@ import (
 // @Param url formData string false "Skill URL"
 // @Param is_external_url formData bool false "Is external URL"
 // @Param is_external_image_url formData bool false "Is external image URL"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /skill [post]
 func CreateSkill(w http.ResponseWriter, r *http.Request) {
 	err := r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
@@ -41,59 +42,64 @@ func CreateSkill(w http.ResponseWriter, r *http.Request) {
 	url := r.FormValue("url")
 	isExternalUrl := helper.ParseIDStringToBool(r.FormValue("is_external_url"))
 	isExternalImageUrl := helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+
+	// Create a new skill record
+	newSkill := models.Skill{
+		Code:               code,
+		Name:               name,
+		Url:                url,
+		IsExternalUrl:      isExternalUrl,
+		IsExternalImageUrl: isExternalImageUrl,
+	}
+	if fieldErrors := helper.ValidateStruct(newSkill); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("image_file")
+	file, _, err := r.FormFile("image_file")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	skillRepo := repositories.NewSkillRepository()
 	// validation name and code unique
-	exist_skill, _ := skillRepo.ReadByNameOrCode(name, code)
-	if exist_skill != nil {
-		// Handle error
-		response := map[string]string{"message": "Name or Code already used"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+	exist, err := skillRepo.ExistsByNameOrCode(name, code, 0)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
 		return
 	}
-
-	// validation location image
-	var directory = "./assets/images/skill"
-	if err := os.MkdirAll(directory, 0755); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
 		return
 	}
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	var finalFilename = code + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
 
-	// Create a new skill record
-	newSkill := models.Skill{
-		Code:               code,
-		Name:               name,
-		ImageUrl:           imageUrl,
-		Url:                url,
-		IsExternalUrl:      isExternalUrl,
-		IsExternalImageUrl: isExternalImageUrl,
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectorySkill)
+	if !ok {
+		return
 	}
+	newSkill.ImageUrl = imageUrl
+
 	// insert to database
-	if newSkill, err := skillRepo.Create(&newSkill); err != nil {
+	if newSkill, err := unitOfWork.SkillRepository().Create(&newSkill); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new skill"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new skill")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new skill")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newSkill.ID,
 			},
 		}
@@ -110,35 +116,54 @@ func CreateSkill(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
+// @Param q query string false "Search code or name"
+// @Param sort query string false "Sort column" Enums(id, code, name, created_at, updated_at) default(id)
+// @Param order query string false "Sort order" Enums(asc, desc) default(asc)
+// @Param created_from query string false "Created at from, YYYY-MM-DD or RFC3339"
+// @Param created_to query string false "Created at to (inclusive), YYYY-MM-DD or RFC3339"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /skill [get]
 func GetFilteredPaginatedSkills(w http.ResponseWriter, r *http.Request) {
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	skillRepo := repositories.NewSkillRepository()
-	totalCount, err := skillRepo.Count()
+	listQuery, err := helper.ParseListQuery(r, repositories.MasterDataSortColumns)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
 		return
 	}
+	pagination := listQuery.Pagination
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	skillRepo := repositories.NewSkillRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = skillRepo.Count(listQuery)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
 
-	skills, err := skillRepo.ReadFilteredPaginated(pageSize, pageNumber)
+	skills, err := skillRepo.ReadFilteredPaginated(listQuery)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch skills"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch skills")
 		return
 	}
 
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		skills, nextCursor, prevCursor, err = helper.BuildCursorPage(skills, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
+	}
+
 	var filteredSkills []models.SkillAllResponse
 	for _, skill := range skills {
-		fullImageURL := helper.GetFullImageUrl(skill.ImageUrl, r)
+		fullImageURL := storages.GetPublicURL(skill.ImageUrl)
 
 		filteredSkills = append(filteredSkills, models.SkillAllResponse{
 			ID:        skill.ID,
@@ -151,20 +176,14 @@ func GetFilteredPaginatedSkills(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if filteredSkills == nil {
-		response := map[string]string{"message": "skills not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "skills not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredSkills,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredSkills,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -177,16 +196,16 @@ func GetFilteredPaginatedSkills(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Skill ID"
-// @Success 200 {object} map[string]string "Success"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /skill/{id} [get]
 func ReadSkill(w http.ResponseWriter, r *http.Request) {
 	// Extract skill ID from the request URL
 	vars := mux.Vars(r)
 	skillIDStr, ok := vars["id"]
 	if !ok {
-		http.Error(w, "Skill ID not provided", http.StatusBadRequest)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not provided")
 		return
 	}
 
@@ -195,16 +214,15 @@ func ReadSkill(w http.ResponseWriter, r *http.Request) {
 	skillRepo := repositories.NewSkillRepository()
 	skill, err := skillRepo.Read(skillID)
 	if err != nil {
-		response := map[string]string{"message": "Skill ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Skill ID not found")
 		return
 	}
 
-	fullImageURL := helper.GetFullImageUrl(skill.ImageUrl, r)
+	fullImageURL := storages.GetPublicURL(skill.ImageUrl)
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
 			"id":                    skill.ID,
 			"code":                  skill.Code,
 			"name":                  skill.Name,
@@ -219,3 +237,179 @@ func ReadSkill(w http.ResponseWriter, r *http.Request) {
 
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
+
+// UpdateSkill godoc
+// @Summary Update skill
+// @Description Update skill with optional file replacement, only provided fields are changed
+// @Tags skills
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "Skill ID"
+// @Param code formData string false "Skill code"
+// @Param name formData string false "Skill name"
+// @Param url formData string false "Skill URL"
+// @Param is_external_url formData bool false "Is external URL"
+// @Param is_external_image_url formData bool false "Is external image URL"
+// @Param image_file formData file false "Skill image file"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /skill/{id} [patch]
+func UpdateSkill(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	skillIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not provided")
+		return
+	}
+
+	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
+		return
+	}
+
+	skillRepo := repositories.NewSkillRepository()
+	skillID := helper.ParseIDStringToInt(skillIDStr)
+	skill, err := skillRepo.Read(skillID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Skill ID not found")
+		return
+	}
+
+	if r.PostForm.Has("code") {
+		skill.Code = r.FormValue("code")
+	}
+	if r.PostForm.Has("name") {
+		skill.Name = r.FormValue("name")
+	}
+
+	// validation name and code unique in other skill
+	exist, err := skillRepo.ExistsByNameOrCode(skill.Name, skill.Code, skill.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to check name and code")
+		return
+	}
+	if exist {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Name or Code already used")
+		return
+	}
+
+	if r.PostForm.Has("url") {
+		skill.Url = r.FormValue("url")
+	}
+	if r.PostForm.Has("is_external_url") {
+		skill.IsExternalUrl = helper.ParseIDStringToBool(r.FormValue("is_external_url"))
+	}
+	if r.PostForm.Has("is_external_image_url") {
+		skill.IsExternalImageUrl = helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+	}
+
+	if fieldErrors := helper.ValidateStruct(skill); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := skill.ImageUrl
+	file, _, err := r.FormFile("image_file")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectorySkill)
+		if !ok {
+			return
+		}
+		skill.ImageUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.SkillRepository().Update(skill); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update skill")
+		return
+	}
+
+	if storages.KeyFromPath(skill.ImageUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update skill")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// DeleteSkill godoc
+// @Summary Delete skill
+// @Description Delete skill, refused while user skill rows still reference it unless force is true
+// @Tags skills
+// @Accept json
+// @Produce json
+// @Param id path int true "Skill ID"
+// @Param force query bool false "Delete together with referencing rows"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Router /skill/{id} [delete]
+func DeleteSkill(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	skillIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not provided")
+		return
+	}
+
+	force := helper.ParseIDStringToBool(r.URL.Query().Get("force"))
+
+	skillRepo := repositories.NewSkillRepository()
+	skillID := helper.ParseIDStringToInt(skillIDStr)
+	skill, err := skillRepo.Read(skillID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Skill ID not found")
+		return
+	}
+
+	// the foreign keys cascade, so deleting a used skill also deletes user data
+	totalReference, err := skillRepo.CountReferences(skillID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch total reference")
+		return
+	}
+
+	if totalReference > 0 && !force {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, fmt.Sprintf("Skill still used by %d user skill rows, use force=true to delete", totalReference))
+		return
+	}
+
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	if err := unitOfWork.SkillRepository().Delete(skillID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete skill")
+		return
+	}
+
+	storages.DeleteImage(unitOfWork.Files, skill.ImageUrl)
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete skill")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
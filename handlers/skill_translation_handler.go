@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,8 +17,10 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.SkillTranslationCreateForm true "Skill translation input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /skill-translation [post]
 func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 
@@ -27,10 +28,16 @@ func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 	var skillTranslationInput models.SkillTranslationCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&skillTranslationInput); err != nil {
-		log.Fatal("Error decoding new skill translation: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode skill translation input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(skillTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	languageRepo := repositories.NewLanguageRepository()
 	skillRepo := repositories.NewSkillRepository()
 	skillTranslationRepo := repositories.NewSkillTranslationRepository()
@@ -39,8 +46,7 @@ func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 	_, lang_err := languageRepo.Read(skillTranslationInput.LanguageID)
 	if lang_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Lang ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Lang ID not found")
 		return
 	}
 
@@ -48,8 +54,7 @@ func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 	_, skill_err := skillRepo.Read(skillTranslationInput.SkillID)
 	if skill_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Skill ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not found")
 		return
 	}
 
@@ -57,8 +62,7 @@ func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 	exist_data, _ := skillTranslationRepo.ReadByLanguageIDSkillID(skillTranslationInput.LanguageID, skillTranslationInput.SkillID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Skill Translation already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Skill Translation already added")
 		return
 	}
 
@@ -71,13 +75,12 @@ func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newSkillTranslation, err := skillTranslationRepo.Create(&newSkillTranslationData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new skill translation"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new skill translation")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newSkillTranslation.ID,
 			},
 		}
@@ -86,6 +89,73 @@ func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update skill translation godoc
+// @Summary Update Skill Translation
+// @Description Update skill translation, only provided fields are changed
+// @Tags skills
+// @Accept json
+// @Produce json
+// @Param skill_id path int true "Skill ID"
+// @Param language_id path int true "Language ID"
+// @Param input body models.SkillTranslationEditForm true "Skill translation input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /skill-translation/{skill_id}/{language_id} [put]
+// @Router /skill-translation/{skill_id}/{language_id} [patch]
+func UpdateSkillTranslation(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	skillIDStr, ok := vars["skill_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not provided")
+		return
+	}
+
+	languageIDStr, ok := vars["language_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	var skillTranslationInput models.SkillTranslationEditForm
+	if err := json.NewDecoder(r.Body).Decode(&skillTranslationInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode skill translation input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(skillTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	skillTranslationRepo := repositories.NewSkillTranslationRepository()
+	skillID := helper.ParseIDStringToInt(skillIDStr)
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+
+	skillTranslation, err := skillTranslationRepo.ReadByLanguageIDSkillID(languageID, skillID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Skill Translation not found")
+		return
+	}
+
+	if skillTranslationInput.Description != nil {
+		skillTranslation.Description = *skillTranslationInput.Description
+	}
+
+	if _, err := skillTranslationRepo.Update(skillTranslation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update skill translation")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user skill godoc
 // @Summary Delete User Skill
 // @Description Delete user skill
@@ -94,23 +164,21 @@ func CreateSkillTranslation(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param skill_id path int true "Skill ID"
 // @Param language_id path int true "Language ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /skill-translation/{skill_id}/{language_id} [delete]
 func DeleteSkillTranslation(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	skillIDStr, ok := vars["skill_id"]
 	if !ok {
-		response := map[string]string{"message": "Skill ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not provided")
 		return
 	}
 
 	languageIDStr, ok := vars["language_id"]
 	if !ok {
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
 		return
 	}
 
@@ -120,13 +188,12 @@ func DeleteSkillTranslation(w http.ResponseWriter, r *http.Request) {
 
 	err := skillTranslationRepo.DeleteByLanguageIDSkillID(languageID, skillID)
 	if err != nil {
-		response := map[string]string{"message": "Skill ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Skill ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
+)
+
+const (
+	storageCheckModeDryRun = "dry_run"
+	storageCheckModeDelete = "delete"
+)
+
+type storageDanglingReference struct {
+	models.StorageReference
+	MissingKeys []string `json:"missing_keys"`
+}
+
+// Check Storage godoc
+// @Summary Check storage consistency
+// @Description Compare uploaded image in storage with every image column, admin only. Orphan is a file no row point to, dangling reference is a row pointing to a missing file. Mode dry_run only report, mode delete also delete orphan older than STORAGE_ORPHAN_MIN_AGE, dangling reference is only reported
+// @Tags storage
+// @Produce json
+// @Param mode query string false "dry_run (default) or delete" Enums(dry_run, delete)
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Failure 403 {object} helper.Response "Forbidden"
+// @Failure 500 {object} helper.Response "Internal Server Error"
+// @Router /storage/consistency [post]
+func CheckStorageConsistency(w http.ResponseWriter, r *http.Request) {
+	mode := r.URL.Query().Get("mode")
+	if mode == "" {
+		mode = storageCheckModeDryRun
+	}
+	if mode != storageCheckModeDryRun && mode != storageCheckModeDelete {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Mode must be dry_run or delete")
+		return
+	}
+
+	// rows are read before files, a file uploaded in between is at most an orphan younger than min age
+	storageReferenceRepo := repositories.NewStorageReferenceRepository()
+	references, err := storageReferenceRepo.ReadAll()
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch storage references")
+		return
+	}
+
+	storageConfig := config.GetStorageConfig()
+	storage := storages.NewStorage(storageConfig)
+	storedFiles, err := storage.List("images/")
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to list storage")
+		return
+	}
+
+	storedKeys := map[string]bool{}
+	for _, storedFile := range storedFiles {
+		storedKeys[storedFile.Key] = true
+	}
+
+	referencedKeys := map[string]bool{}
+	danglingReferences := []storageDanglingReference{}
+	for _, reference := range references {
+		if strings.HasPrefix(reference.Path, "http://") || strings.HasPrefix(reference.Path, "https://") {
+			continue
+		}
+
+		var missingKeys []string
+		for _, key := range storages.ImageVariantKeys(storages.KeyFromPath(reference.Path)) {
+			referencedKeys[key] = true
+			if !storedKeys[key] {
+				missingKeys = append(missingKeys, key)
+			}
+		}
+		if len(missingKeys) > 0 {
+			slices.Sort(missingKeys)
+			danglingReferences = append(danglingReferences, storageDanglingReference{StorageReference: reference, MissingKeys: missingKeys})
+		}
+	}
+
+	// only file named by upload is an orphan, README and documentation image are never touched
+	orphans := storages.FindOrphans(storedFiles, referencedKeys, imageDirectories)
+
+	deleted, skipped, failed := []string{}, []string{}, []string{}
+	if mode == storageCheckModeDelete {
+		deleted, skipped, failed = storages.DeleteOrphans(storage, orphans, time.Now().Add(-storageConfig.OrphanMinAge))
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"mode":                mode,
+			"scanned_files":       len(storedFiles),
+			"references":          len(references),
+			"orphans":             orphans,
+			"dangling_references": danglingReferences,
+			"deleted":             deleted,
+			"skipped":             skipped,
+			"failed":              failed,
+		},
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
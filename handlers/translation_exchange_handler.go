@@ -0,0 +1,367 @@
+package handlers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+)
+
+const (
+	translationImportMaxBytes = 5 << 20
+	xliffNamespace            = "urn:oasis:names:tc:xliff:document:1.2"
+)
+
+// section -> item id -> language id -> field -> value
+type translationValues map[string]map[int64]map[int64]map[string]string
+
+// Export Translation godoc
+// @Summary Export translation
+// @Description Export translatable strings of logged in user (experience, education, project, language and skill) for a source/target language pair as XLIFF 1.2 or JSON, only item which has source translation is exported
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Produce application/x-xliff+xml
+// @Param source_language query string true "Source language code"
+// @Param target_language query string true "Target language code"
+// @Param format query string false "File format" Enums(json, xliff) default(json)
+// @Success 200 {object} models.TranslationDocument "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Router /profile/translation/export [get]
+func ExportTranslation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	format, ok := getTranslationFormat(r)
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Format must be json or xliff")
+		return
+	}
+
+	sourceLanguage, targetLanguage, err := readTranslationLanguagePair(r.URL.Query().Get("source_language"), r.URL.Query().Get("target_language"))
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	values, err := readTranslationValues(jwtClaim.Id, sourceLanguage.ID, targetLanguage.ID)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to fetch translations")
+		return
+	}
+
+	document := models.TranslationDocument{
+		SourceLanguage: sourceLanguage.Code,
+		TargetLanguage: targetLanguage.Code,
+		Units:          []models.TranslationUnit{},
+	}
+	for _, section := range models.TranslationSections {
+		var itemIDs []int64
+		for itemID := range values[section] {
+			itemIDs = append(itemIDs, itemID)
+		}
+		slices.Sort(itemIDs)
+
+		for _, itemID := range itemIDs {
+			source, ok := values[section][itemID][sourceLanguage.ID]
+			if !ok {
+				continue
+			}
+			target := values[section][itemID][targetLanguage.ID]
+			for _, field := range models.TranslationExchangeFields[section] {
+				document.Units = append(document.Units, models.TranslationUnit{
+					ID:        fmt.Sprintf("%s/%d/%s", section, itemID, field),
+					Source:    source[field],
+					Target:    target[field],
+					MaxLength: helper.GetSizeLimit(models.TranslationExchangeModels[section], field),
+				})
+			}
+		}
+	}
+
+	filename := fmt.Sprintf("translation-%s-%s", sourceLanguage.Code, targetLanguage.Code)
+	if format == models.TranslationFormatXLIFF {
+		xliffDocument := models.XLIFFDocument{
+			Version: "1.2",
+			Xmlns:   xliffNamespace,
+			File: models.XLIFFFile{
+				Original:       "personal-portfolio",
+				SourceLanguage: document.SourceLanguage,
+				TargetLanguage: document.TargetLanguage,
+				Datatype:       "plaintext",
+			},
+		}
+		for _, unit := range document.Units {
+			xliffDocument.File.TransUnits = append(xliffDocument.File.TransUnits, models.XLIFFTransUnit{
+				ID:       unit.ID,
+				MaxWidth: unit.MaxLength,
+				SizeUnit: "char",
+				Source:   unit.Source,
+				Target:   unit.Target,
+			})
+		}
+
+		output, err := xml.MarshalIndent(xliffDocument, "", "  ")
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to encode xliff")
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-xliff+xml; charset=utf-8")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.xlf"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(xml.Header))
+		w.Write(output)
+		return
+	}
+
+	// downloaded file is the document itself, not the response envelope
+	output, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to encode json")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`.json"`)
+	w.WriteHeader(http.StatusOK)
+	w.Write(output)
+}
+
+// Import Translation godoc
+// @Summary Import translation
+// @Description Import translated XLIFF 1.2 or JSON file from export, target translations are created or updated in one transaction. Nothing is saved when one of the units is invalid, every invalid unit is reported. Unit with empty target is skipped, skill translation is master data and can only be imported by admin
+// @Tags auth
+// @Accept json
+// @Accept application/x-xliff+xml
+// @Produce json
+// @Param format query string false "File format" Enums(json, xliff) default(json)
+// @Param input body models.TranslationDocument true "Translation document"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Failure 413 {object} helper.Response "Request Entity Too Large"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Router /profile/translation/import [post]
+func ImportTranslation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	format, ok := getTranslationFormat(r)
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Format must be json or xliff")
+		return
+	}
+
+	defer r.Body.Close()
+	document, err := decodeTranslationDocument(http.MaxBytesReader(w, r.Body, translationImportMaxBytes), format)
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			helper.ResponseError(w, http.StatusRequestEntityTooLarge, helper.ErrorCodePayloadTooLarge, "Translation file is too large")
+			return
+		}
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode translation input")
+		return
+	}
+
+	sourceLanguage, targetLanguage, err := readTranslationLanguagePair(document.SourceLanguage, document.TargetLanguage)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
+		return
+	}
+
+	values, err := readTranslationValues(jwtClaim.Id, sourceLanguage.ID, targetLanguage.ID)
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to fetch translations")
+		return
+	}
+
+	unitErrors := []models.TranslationUnitError{}
+	skipped := 0
+	var updates []models.TranslationExchangeUpdate
+	updateIndex := map[string]int{}
+	for _, unit := range document.Units {
+		section, itemID, field, message := parseTranslationUnitID(unit.ID)
+		if message == "" {
+			itemValues := values[section][itemID]
+			_, hasSource := itemValues[sourceLanguage.ID]
+			_, hasTarget := itemValues[targetLanguage.ID]
+			maxLength := helper.GetSizeLimit(models.TranslationExchangeModels[section], field)
+
+			switch {
+			case !hasSource && !hasTarget:
+				message = "Source translation not found"
+			case section == models.TranslationSectionSkill && jwtClaim.Role != models.UserRoleAdmin:
+				message = "Skill translation can only be imported by admin"
+			case utf8.RuneCountInString(unit.Target) > maxLength:
+				message = fmt.Sprintf("Target must be at most %d characters", maxLength)
+			}
+		}
+		if message != "" {
+			unitErrors = append(unitErrors, models.TranslationUnitError{ID: unit.ID, Message: message})
+			continue
+		}
+
+		if strings.TrimSpace(unit.Target) == "" {
+			skipped++
+			continue
+		}
+
+		// group units of the same item
+		key := fmt.Sprintf("%s/%d", section, itemID)
+		index, ok := updateIndex[key]
+		if !ok {
+			index = len(updates)
+			updateIndex[key] = index
+			updates = append(updates, models.TranslationExchangeUpdate{Section: section, ItemID: itemID, Values: map[string]string{}})
+		}
+		updates[index].Values[field] = unit.Target
+	}
+
+	if len(unitErrors) > 0 {
+		response := helper.Response{
+			Error: &helper.ErrorBody{
+				Code:    helper.ErrorCodeBadRequest,
+				Message: "Failed to import translation",
+				Details: unitErrors,
+			},
+		}
+		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		return
+	}
+
+	translationExchangeRepo := repositories.NewTranslationExchangeRepository()
+	created, updated, err := translationExchangeRepo.Upsert(sourceLanguage.ID, targetLanguage.ID, updates)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to import translation")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"created": created,
+			"updated": updated,
+			"skipped": skipped,
+		},
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+func getTranslationFormat(r *http.Request) (string, bool) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		return models.TranslationFormatJSON, true
+	}
+	return format, format == models.TranslationFormatJSON || format == models.TranslationFormatXLIFF
+}
+
+func readTranslationLanguagePair(sourceCode, targetCode string) (*models.Language, *models.Language, error) {
+	if sourceCode == "" || targetCode == "" {
+		return nil, nil, errors.New("Source and target language are required")
+	}
+	if strings.EqualFold(sourceCode, targetCode) {
+		return nil, nil, errors.New("Source and target language must be different")
+	}
+
+	languageRepo := repositories.NewLanguageRepository()
+	sourceLanguage, err := languageRepo.ReadByCode(sourceCode)
+	if err != nil {
+		return nil, nil, errors.New("Source language not found")
+	}
+	targetLanguage, err := languageRepo.ReadByCode(targetCode)
+	if err != nil {
+		return nil, nil, errors.New("Target language not found")
+	}
+	return sourceLanguage, targetLanguage, nil
+}
+
+func readTranslationValues(userID, sourceLanguageID, targetLanguageID int64) (translationValues, error) {
+	translationExchangeRepo := repositories.NewTranslationExchangeRepository()
+	values := translationValues{}
+	for _, section := range models.TranslationSections {
+		values[section] = map[int64]map[int64]map[string]string{}
+		for _, field := range models.TranslationExchangeFields[section] {
+			fieldValues, err := translationExchangeRepo.ReadValues(section, field, userID, []int64{sourceLanguageID, targetLanguageID})
+			if err != nil {
+				return nil, err
+			}
+			for _, fieldValue := range fieldValues {
+				if values[section][fieldValue.ItemID] == nil {
+					values[section][fieldValue.ItemID] = map[int64]map[string]string{}
+				}
+				if values[section][fieldValue.ItemID][fieldValue.LanguageID] == nil {
+					values[section][fieldValue.ItemID][fieldValue.LanguageID] = map[string]string{}
+				}
+				values[section][fieldValue.ItemID][fieldValue.LanguageID][field] = fieldValue.Value
+			}
+		}
+	}
+	return values, nil
+}
+
+func decodeTranslationDocument(body io.Reader, format string) (*models.TranslationDocument, error) {
+	if format == models.TranslationFormatJSON {
+		var document models.TranslationDocument
+		if err := json.NewDecoder(body).Decode(&document); err != nil {
+			return nil, err
+		}
+		return &document, nil
+	}
+
+	var xliffDocument models.XLIFFDocument
+	if err := xml.NewDecoder(body).Decode(&xliffDocument); err != nil {
+		return nil, err
+	}
+	document := models.TranslationDocument{
+		SourceLanguage: xliffDocument.File.SourceLanguage,
+		TargetLanguage: xliffDocument.File.TargetLanguage,
+	}
+	for _, transUnit := range xliffDocument.File.TransUnits {
+		document.Units = append(document.Units, models.TranslationUnit{
+			ID:     transUnit.ID,
+			Source: transUnit.Source,
+			Target: transUnit.Target,
+		})
+	}
+	return &document, nil
+}
+
+// split "<section>/<item id>/<field>", message is not empty when unit id is invalid
+func parseTranslationUnitID(unitID string) (section string, itemID int64, field string, message string) {
+	parts := strings.Split(unitID, "/")
+	if len(parts) != 3 {
+		return "", 0, "", "Unit id must be <section>/<item id>/<field>"
+	}
+
+	section, field = parts[0], parts[2]
+	fields, ok := models.TranslationExchangeFields[section]
+	if !ok {
+		return "", 0, "", "Unknown section " + section
+	}
+	if !slices.Contains(fields, field) {
+		return "", 0, "", "Unknown field " + field
+	}
+	itemID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || itemID <= 0 {
+		return "", 0, "", "Invalid item id"
+	}
+	return section, itemID, field, ""
+}
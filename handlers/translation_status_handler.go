@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"math"
+	"net/http"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+)
+
+// Translation Status godoc
+// @Summary Translation status
+// @Description Show for every language which experience, education, project, language and skill of logged in user has no translation yet, with counts and percentages. Use format=csv to download missing rows for translator
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Produce text/csv
+// @Param format query string false "Response format" Enums(json, csv)
+// @Success 200 {object} helper.Response "Success"
+// @Failure 401 {object} helper.Response "Unauthorized"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Router /profile/translation-status [get]
+func GetTranslationStatus(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Format must be json or csv")
+		return
+	}
+
+	languageRepo := repositories.NewLanguageRepository()
+	translationStatusRepo := repositories.NewTranslationStatusRepository()
+
+	languages, err := languageRepo.ReadAll()
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch languages")
+		return
+	}
+
+	items := map[string][]models.TranslationStatusItem{}
+	// section -> item id -> language id
+	translated := map[string]map[int64]map[int64]bool{}
+	for _, section := range models.TranslationSections {
+		sectionItems, err := translationStatusRepo.ReadItems(section, jwtClaim.Id)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch "+section+" rows")
+			return
+		}
+
+		var itemIDs []int64
+		for _, item := range sectionItems {
+			itemIDs = append(itemIDs, item.ID)
+		}
+
+		translations, err := translationStatusRepo.ReadTranslations(section, itemIDs)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch "+section+" translations")
+			return
+		}
+
+		translated[section] = map[int64]map[int64]bool{}
+		for _, translation := range translations {
+			if translated[section][translation.ItemID] == nil {
+				translated[section][translation.ItemID] = map[int64]bool{}
+			}
+			translated[section][translation.ItemID][translation.LanguageID] = true
+		}
+		items[section] = sectionItems
+	}
+
+	var statuses []models.LanguageTranslationStatus
+	for _, language := range languages {
+		status := models.LanguageTranslationStatus{
+			LanguageID:   language.ID,
+			LanguageCode: language.Code,
+			LanguageName: language.Name,
+		}
+		for _, section := range models.TranslationSections {
+			sectionStatus := models.TranslationSectionStatus{
+				Section:      section,
+				Total:        len(items[section]),
+				MissingItems: []models.TranslationStatusItem{},
+			}
+			for _, item := range items[section] {
+				if translated[section][item.ID][language.ID] {
+					sectionStatus.Translated++
+				} else {
+					sectionStatus.MissingItems = append(sectionStatus.MissingItems, item)
+				}
+			}
+			sectionStatus.Missing = len(sectionStatus.MissingItems)
+			sectionStatus.Percentage = translationPercentage(sectionStatus.Translated, sectionStatus.Total)
+
+			status.Total += sectionStatus.Total
+			status.Translated += sectionStatus.Translated
+			status.Missing += sectionStatus.Missing
+			status.Sections = append(status.Sections, sectionStatus)
+		}
+		status.Percentage = translationPercentage(status.Translated, status.Total)
+		statuses = append(statuses, status)
+	}
+
+	if format == "csv" {
+		writeTranslationStatusCSV(w, statuses)
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data:    statuses,
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// translated percentage with 2 decimal, nothing to translate mean complete
+func translationPercentage(translated, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	return math.Round(float64(translated)/float64(total)*10000) / 100
+}
+
+// one line for every missing translation
+func writeTranslationStatusCSV(w http.ResponseWriter, statuses []models.LanguageTranslationStatus) {
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="translation-status.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(w)
+	writer.Write([]string{"language_id", "language_code", "language_name", "section", "item_id", "label"})
+	for _, status := range statuses {
+		for _, section := range status.Sections {
+			for _, item := range section.MissingItems {
+				writer.Write([]string{
+					helper.ParseIDIntToString(status.LanguageID),
+					status.LanguageCode,
+					status.LanguageName,
+					section.Section,
+					helper.ParseIDIntToString(item.ID),
+					item.Label,
+				})
+			}
+		}
+	}
+	writer.Flush()
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
+)
+
+// directory of uploaded image in the storage, storage check only scan these
+const (
+	imageDirectoryCompany               = "images/company"
+	imageDirectorySchool                = "images/school"
+	imageDirectorySkill                 = "images/skill"
+	imageDirectoryProjectPlatform       = "images/project/platform"
+	imageDirectoryLanguage              = "images/language"
+	imageDirectoryUserAttachment        = "images/user/attachment"
+	imageDirectoryUserProject           = "images/user/project/showcase"
+	imageDirectoryUserProjectAttachment = "images/user/project/showcase_attachment"
+)
+
+var imageDirectories = []string{
+	imageDirectoryCompany,
+	imageDirectorySchool,
+	imageDirectorySkill,
+	imageDirectoryProjectPlatform,
+	imageDirectoryLanguage,
+	imageDirectoryUserAttachment,
+	imageDirectoryUserProject,
+	imageDirectoryUserProjectAttachment,
+}
+
+// store image form file under directory and return its key, return false when response already written
+func uploadImageFile(w http.ResponseWriter, storage storages.Storage, file io.Reader, field string, directory string) (string, bool) {
+	uploader := storages.NewUploader(storage, config.GetUploadConfig())
+	key, err := uploader.UploadImage(directory, file)
+	if err == nil {
+		return key, true
+	}
+
+	var fileTooLargeError *storages.FileTooLargeError
+	switch {
+	case errors.Is(err, storages.ErrFileTypeNotAllowed):
+		helper.ResponseValidationError(w, []helper.FieldError{{
+			Field:   field,
+			Code:    helper.FieldErrorInvalidType,
+			Message: fmt.Sprintf("%s must be one of %s", field, strings.Join(uploader.AllowedImageTypes(), ", ")),
+		}})
+	case errors.Is(err, storages.ErrInvalidImage):
+		helper.ResponseValidationError(w, []helper.FieldError{{
+			Field:   field,
+			Code:    helper.FieldErrorInvalidType,
+			Message: field + " must be a valid image",
+		}})
+	case errors.As(err, &fileTooLargeError):
+		helper.ResponseValidationError(w, []helper.FieldError{{
+			Field:   field,
+			Code:    helper.FieldErrorTooLarge,
+			Message: fmt.Sprintf("%s must be at most %d bytes for %s", field, fileTooLargeError.MaxSize, fileTooLargeError.ContentType),
+		}})
+	default:
+		log.Println("Failed to upload file: ", err)
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to upload file")
+	}
+	return "", false
+}
@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/gorilla/mux"
+)
+
+// Create User API Key godoc
+// @Summary Create API key
+// @Description Create API key for headless frontend, the key is only shown once. Available scopes: read:drafts, write:projects
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param input body models.UserAPIKeyCreateForm true "API key input"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /profile/api-key [post]
+func CreateUserAPIKey(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	var apiKeyInput models.UserAPIKeyCreateForm
+	if err := json.NewDecoder(r.Body).Decode(&apiKeyInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode api key input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(apiKeyInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// validate scopes and remove duplicate
+	var scopes []string
+	for _, scope := range apiKeyInput.Scopes {
+		if !slices.Contains(models.APIKeyScopes, scope) {
+			helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Scope "+scope+" is not available")
+			return
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "At least one scope is required")
+		return
+	}
+
+	apiKey, err := helper.GenerateAPIKey()
+	if err != nil {
+		helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, err.Error())
+		return
+	}
+
+	newUserAPIKeyData := models.UserAPIKey{
+		UserID:  uint(jwtClaim.Id),
+		Name:    apiKeyInput.Name,
+		Prefix:  apiKey[:len(helper.APIKeyPrefix)+8],
+		KeyHash: helper.HashToken(apiKey),
+		Scopes:  strings.Join(scopes, ","),
+	}
+
+	apiKeyRepo := repositories.NewUserAPIKeyRepository()
+	newUserAPIKey, err := apiKeyRepo.Create(&newUserAPIKeyData)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Error creating new api key")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"id":     newUserAPIKey.ID,
+			"name":   newUserAPIKey.Name,
+			"key":    apiKey,
+			"scopes": newUserAPIKey.ScopeList(),
+		},
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// Get User API Keys godoc
+// @Summary Get API keys
+// @Description Get API keys of logged in user including revoked keys
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Router /profile/api-key [get]
+func GetUserAPIKeys(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	apiKeyRepo := repositories.NewUserAPIKeyRepository()
+	userAPIKeys, err := apiKeyRepo.ReadAll(jwtClaim.Id)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch api keys")
+		return
+	}
+
+	filteredUserAPIKeys := []models.UserAPIKeyResponse{}
+	for _, userAPIKey := range userAPIKeys {
+		filteredUserAPIKeys = append(filteredUserAPIKeys, models.UserAPIKeyResponse{
+			ID:         userAPIKey.ID,
+			Name:       userAPIKey.Name,
+			Prefix:     userAPIKey.Prefix,
+			Scopes:     userAPIKey.ScopeList(),
+			LastUsedAt: userAPIKey.LastUsedAt,
+			RevokedAt:  userAPIKey.RevokedAt,
+			CreatedAt:  userAPIKey.CreatedAt,
+		})
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredUserAPIKeys,
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// Revoke User API Key godoc
+// @Summary Revoke API key
+// @Description Revoke API key of logged in user
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param id path int true "API key ID"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /profile/api-key/{id} [delete]
+func RevokeUserAPIKey(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	vars := mux.Vars(r)
+	apiKeyIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "API key ID not provided in URL")
+		return
+	}
+
+	apiKeyRepo := repositories.NewUserAPIKeyRepository()
+	userAPIKey, err := apiKeyRepo.Read(helper.ParseIDStringToInt(apiKeyIDStr))
+	if err != nil || int64(userAPIKey.UserID) != jwtClaim.Id {
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "API key ID not found")
+		return
+	}
+
+	if err := apiKeyRepo.Revoke(userAPIKey.ID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to revoke api key")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
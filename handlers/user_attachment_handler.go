@@ -2,12 +2,12 @@ package handlers
 
 import (
 	"net/http"
-	"os"
-	"path/filepath"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -23,17 +23,22 @@ import (
 // @Param url formData string false "User attachment URL"
 // @Param is_external_url formData bool false "Is external URL"
 // @Param is_external_image_url formData bool false "Is external image URL"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-attachment [post]
 func CreateUserAttachment(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 
-	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	err = r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
@@ -43,61 +48,62 @@ func CreateUserAttachment(w http.ResponseWriter, r *http.Request) {
 	url := r.FormValue("url")
 	isExternalUrl := helper.ParseIDStringToBool(r.FormValue("is_external_url"))
 	isExternalImageUrl := helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+
+	// Create a new user attachment record
+	newUserAttachmentData := models.UserAttachment{
+		UserID:             uint(userID),
+		Title:              title,
+		Category:           category,
+		Url:                url,
+		IsExternalUrl:      isExternalUrl,
+		IsExternalImageUrl: isExternalImageUrl,
+	}
+	if fieldErrors := helper.ValidateStruct(newUserAttachmentData); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("image_file")
+	file, _, err := r.FormFile("image_file")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	userRepo := repositories.NewUserRepository()
-	userAttachmentRepo := repositories.NewUserAttachmentRepository()
 	// validation name and code unique
 	_, err_user := userRepo.Read(userID)
 	if err_user != nil {
 		// Handle error
-		response := map[string]string{"message": "User not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err_user, "User not found")
 		return
 	}
 
-	// validation location image
-	var directory = "./assets/images/user/attachment"
-	if err := os.MkdirAll(directory, 0755); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryUserAttachment)
+	if !ok {
 		return
 	}
+	newUserAttachmentData.ImageUrl = imageUrl
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	finalFilename := helper.GetStringTimeNow() + "_" + helper.ParseIDIntToString(userID) + "_" + title + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
-
-	// Create a new user attachment record
-	newUserAttachmentData := models.UserAttachment{
-		UserID:             uint(userID),
-		Title:              title,
-		Category:           category,
-		ImageUrl:           imageUrl,
-		Url:                url,
-		IsExternalUrl:      isExternalUrl,
-		IsExternalImageUrl: isExternalImageUrl,
-	}
 	// insert to database
-	if newUserAttachment, err := userAttachmentRepo.Create(&newUserAttachmentData); err != nil {
+	if newUserAttachment, err := unitOfWork.UserAttachmentRepository().Create(&newUserAttachmentData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user attachment"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user attachment")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new user attachment")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserAttachment.ID,
 			},
 		}
@@ -106,6 +112,123 @@ func CreateUserAttachment(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UpdateUserAttachment godoc
+// @Summary Update user attachment
+// @Description Update user attachment with optional file replacement, only provided fields are changed
+// @Tags users
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "User Attachment ID"
+// @Param title formData string false "User attachment title"
+// @Param category formData string false "User attachment category"
+// @Param image_file formData file false "User attachment image file"
+// @Param url formData string false "User attachment URL"
+// @Param is_external_url formData bool false "Is external URL"
+// @Param is_external_image_url formData bool false "Is external image URL"
+// @Param is_active formData bool false "Is active"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-attachment/{id} [patch]
+func UpdateUserAttachment(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	userAttachmentIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Attachment not provided")
+		return
+	}
+
+	err = r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
+		return
+	}
+
+	userAttachmentRepo := repositories.NewUserAttachmentRepository()
+	userAttachmentID := helper.ParseIDStringToInt(userAttachmentIDStr)
+
+	userAttachment, err := userAttachmentRepo.Read(userAttachmentID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Attachment ID not found")
+		return
+	}
+
+	if userAttachment.UserID != uint(userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
+		return
+	}
+
+	if r.PostForm.Has("title") {
+		userAttachment.Title = r.FormValue("title")
+	}
+	if r.PostForm.Has("category") {
+		userAttachment.Category = r.FormValue("category")
+	}
+	if r.PostForm.Has("url") {
+		userAttachment.Url = r.FormValue("url")
+	}
+	if r.PostForm.Has("is_external_url") {
+		userAttachment.IsExternalUrl = helper.ParseIDStringToBool(r.FormValue("is_external_url"))
+	}
+	if r.PostForm.Has("is_external_image_url") {
+		userAttachment.IsExternalImageUrl = helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+	}
+	if r.PostForm.Has("is_active") {
+		userAttachment.IsActive = helper.ParseIDStringToBool(r.FormValue("is_active"))
+	}
+
+	if fieldErrors := helper.ValidateStruct(userAttachment); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := userAttachment.ImageUrl
+	file, _, err := r.FormFile("image_file")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryUserAttachment)
+		if !ok {
+			return
+		}
+		userAttachment.ImageUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.UserAttachmentRepository().Update(userAttachment); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user attachment")
+		return
+	}
+
+	if storages.KeyFromPath(userAttachment.ImageUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user attachment")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user attachment godoc
 // @Summary Delete User attachment
 // @Description Delete user attachment
@@ -113,20 +236,23 @@ func CreateUserAttachment(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User Attachment ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Success 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-attachment/{id} [delete]
 func DeleteUserAttachment(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	userRepoIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": "User Attachment not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Attachment not provided")
 		return
 	}
 
@@ -135,34 +261,33 @@ func DeleteUserAttachment(w http.ResponseWriter, r *http.Request) {
 
 	userAttachment, err := userAttachmentRepo.Read(userAttachmentID)
 	if err != nil {
-		response := map[string]string{"message": "User Attachment ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Attachment ID not found")
 		return
 	}
 
 	if userAttachment.UserID != uint(userID) {
-		response := map[string]string{"message": "Not allowing delete"}
-		helper.ResponseJSON(w, http.StatusForbidden, response)
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing delete")
 		return
 	}
 
-	errDeleteImage := helper.RemoveFile(userAttachment.ImageUrl)
-	if errDeleteImage != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to delete file"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	errDelete := unitOfWork.UserAttachmentRepository().Delete(userAttachmentID)
+	if errDelete != nil {
+		helper.ResponseDatabaseError(w, errDelete, "User Attachment ID not found")
 		return
 	}
 
-	errDelete := userAttachmentRepo.Delete(userAttachmentID)
-	if errDelete != nil {
-		response := map[string]string{"message": "User Attachment ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+	storages.DeleteImage(unitOfWork.Files, userAttachment.ImageUrl)
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete user attachment")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
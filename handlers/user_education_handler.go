@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserEducationCreateForm true "User education input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-education [post]
 func CreateUserEducation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userEducationInput models.UserEducationCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userEducationInput); err != nil {
-		log.Fatal("Error decoding new user education: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user education input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userEducationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	userRepo := repositories.NewUserRepository()
 	schoolRepo := repositories.NewSchoolRepository()
 	userEducationRepo := repositories.NewUserEducationRepository()
@@ -41,8 +52,7 @@ func CreateUserEducation(w http.ResponseWriter, r *http.Request) {
 	_, user_err := userRepo.Read(userID)
 	if user_err != nil {
 		// Handle error
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User ID not found")
 		return
 	}
 
@@ -50,8 +60,7 @@ func CreateUserEducation(w http.ResponseWriter, r *http.Request) {
 	_, school_err := schoolRepo.Read(userEducationInput.SchoolID)
 	if school_err != nil {
 		// Handle error
-		response := map[string]string{"message": "School ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not found")
 		return
 	}
 
@@ -59,8 +68,7 @@ func CreateUserEducation(w http.ResponseWriter, r *http.Request) {
 	exist_data, _ := userEducationRepo.ReadByUserIDSchoolID(userID, userEducationInput.SchoolID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Education already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Education already added")
 		return
 	}
 
@@ -76,13 +84,12 @@ func CreateUserEducation(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserEducation, err := userEducationRepo.Create(&newUserEducationData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user education"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user education")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserEducation.ID,
 			},
 		}
@@ -91,6 +98,88 @@ func CreateUserEducation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user Education godoc
+// @Summary Update User Education
+// @Description Update user Education
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param school_id path int true "School ID"
+// @Param input body models.UserEducationEditForm true "User education input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-education/{school_id} [patch]
+func UpdateUserEducation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	schoolIDStr, ok := vars["school_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not provided")
+		return
+	}
+
+	var userEducationInput models.UserEducationEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userEducationInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user education input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userEducationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userEducationRepo := repositories.NewUserEducationRepository()
+	schoolID := helper.ParseIDStringToInt(schoolIDStr)
+	userEducation, err := userEducationRepo.ReadByUserIDSchoolID(userID, schoolID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Education ID not found")
+		return
+	}
+
+	if userEducationInput.MonthStart != nil {
+		userEducation.MonthStart = *userEducationInput.MonthStart
+	}
+	if userEducationInput.MonthEnd != nil {
+		userEducation.MonthEnd = *userEducationInput.MonthEnd
+	}
+	if userEducationInput.YearStart != nil {
+		userEducation.YearStart = uint(*userEducationInput.YearStart)
+	}
+	if userEducationInput.YearEnd != nil {
+		userEducation.YearEnd = uint(*userEducationInput.YearEnd)
+	}
+	if userEducationInput.IsActive != nil {
+		userEducation.IsActive = *userEducationInput.IsActive
+	}
+
+	// period is checked after merge, one side of the period can be kept from current data
+	if fieldErrors := helper.ValidatePeriod(userEducation.MonthStart, int64(userEducation.YearStart), userEducation.MonthEnd, int64(userEducation.YearEnd)); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	if _, err := userEducationRepo.Update(userEducation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user education")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user Education godoc
 // @Summary Delete User Education
 // @Description Delete user Education
@@ -98,33 +187,35 @@ func CreateUserEducation(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param school_id path int true "School ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-education/{school_id} [delete]
 func DeleteUserEducation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	schoolIDStr, ok := vars["school_id"]
 	if !ok {
-		response := map[string]string{"message": "School ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not provided")
 		return
 	}
 
 	userEducationRepo := repositories.NewUserEducationRepository()
 	schoolID := helper.ParseIDStringToInt(schoolIDStr)
-	err := userEducationRepo.DeleteByUserIDSchoolID(userID, schoolID)
+	err = userEducationRepo.DeleteByUserIDSchoolID(userID, schoolID)
 	if err != nil {
-		response := map[string]string{"message": "User Education ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Education ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
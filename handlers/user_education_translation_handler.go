@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,22 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserEducationTranslationCreateForm true "User education input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-education-translation [post]
 func CreateUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userEducationTranslationInput models.UserEducationTranslationCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userEducationTranslationInput); err != nil {
-		print(err)
-		log.Fatal("Error decoding new user education: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user education translation input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userEducationTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	languageRepo := repositories.NewLanguageRepository()
 	userEducationRepo := repositories.NewUserEducationRepository()
 	userEducationTranslationRepo := repositories.NewUserEducationTranslationRepository()
@@ -42,8 +52,7 @@ func CreateUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
 	_, language_err := languageRepo.Read(userEducationTranslationInput.LanguageID)
 	if language_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not found")
 		return
 	}
 
@@ -51,16 +60,14 @@ func CreateUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
 	userEducation, user_education_err := userEducationRepo.ReadByUserIDSchoolID(userID, userEducationTranslationInput.SchoolID)
 	if user_education_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Education not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Education not found")
 		return
 	}
 
 	exist_data, _ := userEducationTranslationRepo.ReadByLanguageIDUserEducationID(userEducationTranslationInput.LanguageID, userEducation.ID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Education already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Education already added")
 		return
 	}
 
@@ -77,13 +84,12 @@ func CreateUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserEducationTranslation, err := userEducationTranslationRepo.Create(&newUserEducationTranslationData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user education translation"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user education translation")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserEducationTranslation.ID,
 			},
 		}
@@ -92,6 +98,100 @@ func CreateUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user Education Translation godoc
+// @Summary Update User Education Translation
+// @Description Update user Education Translation, only provided fields are changed
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param school_id path int true "School ID"
+// @Param language_id path int true "Language ID"
+// @Param input body models.UserEducationTranslationEditForm true "User education input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-education-translation/{school_id}/{language_id} [put]
+// @Router /user-education-translation/{school_id}/{language_id} [patch]
+func UpdateUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	schoolIDStr, ok := vars["school_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not provided")
+		return
+	}
+
+	languageIDStr, ok := vars["language_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	var userEducationTranslationInput models.UserEducationTranslationEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userEducationTranslationInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user education translation input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userEducationTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userEducationRepo := repositories.NewUserEducationRepository()
+	userEducationTranslationRepo := repositories.NewUserEducationTranslationRepository()
+	schoolID := helper.ParseIDStringToInt(schoolIDStr)
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+
+	userEducation, user_education_err := userEducationRepo.ReadByUserIDSchoolID(userID, schoolID)
+	if user_education_err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "Education not found")
+		return
+	}
+
+	userEducationTranslation, err := userEducationTranslationRepo.ReadByLanguageIDUserEducationID(languageID, userEducation.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Education Translation ID not found")
+		return
+	}
+
+	if userEducationTranslationInput.Title != nil {
+		userEducationTranslation.Title = *userEducationTranslationInput.Title
+	}
+	if userEducationTranslationInput.Description != nil {
+		userEducationTranslation.Description = *userEducationTranslationInput.Description
+	}
+	if userEducationTranslationInput.Category != nil {
+		userEducationTranslation.Category = *userEducationTranslationInput.Category
+	}
+	if userEducationTranslationInput.Location != nil {
+		userEducationTranslation.Location = *userEducationTranslationInput.Location
+	}
+	if userEducationTranslationInput.LocationType != nil {
+		userEducationTranslation.LocationType = *userEducationTranslationInput.LocationType
+	}
+
+	if _, err := userEducationTranslationRepo.Update(userEducationTranslation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user education translation")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user Education Translation godoc
 // @Summary Delete User Education Translation
 // @Description Delete user Education Translation
@@ -100,26 +200,28 @@ func CreateUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param school_id path int true "School ID"
 // @Param language_id path int true "Language ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-education-translation/{school_id}/{language_id} [delete]
 func DeleteUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	schoolIDStr, ok := vars["school_id"]
 	if !ok {
-		response := map[string]string{"message": "School ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "School ID not provided")
 		return
 	}
 
 	languageIDStr, ok := vars["language_id"]
 	if !ok {
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
 		return
 	}
 
@@ -131,20 +233,18 @@ func DeleteUserEducationTranslation(w http.ResponseWriter, r *http.Request) {
 	userEducation, user_education_err := userEducationRepo.ReadByUserIDSchoolID(userID, schoolID)
 	if user_education_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Education not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Education not found")
 		return
 	}
 
-	err := userEducationTranslationRepo.DeleteByLanguageIDUserEducationID(languageID, userEducation.ID)
+	err = userEducationTranslationRepo.DeleteByLanguageIDUserEducationID(languageID, userEducation.ID)
 	if err != nil {
-		response := map[string]string{"message": "User Education Translation ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Education Translation ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
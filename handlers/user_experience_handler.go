@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserExperienceCreateForm true "User experience input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-experience [post]
 func CreateUserExperience(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userExperienceInput models.UserExperienceCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userExperienceInput); err != nil {
-		log.Fatal("Error decoding new user experience: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user experience input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userExperienceInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	userRepo := repositories.NewUserRepository()
 	companyRepo := repositories.NewCompanyRepository()
 	userExperienceRepo := repositories.NewUserExperienceRepository()
@@ -41,8 +52,7 @@ func CreateUserExperience(w http.ResponseWriter, r *http.Request) {
 	_, user_err := userRepo.Read(userID)
 	if user_err != nil {
 		// Handle error
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User ID not found")
 		return
 	}
 
@@ -50,8 +60,7 @@ func CreateUserExperience(w http.ResponseWriter, r *http.Request) {
 	_, company_err := companyRepo.Read(userExperienceInput.CompanyID)
 	if company_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Company ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not found")
 		return
 	}
 
@@ -59,8 +68,7 @@ func CreateUserExperience(w http.ResponseWriter, r *http.Request) {
 	exist_data, _ := userExperienceRepo.ReadByUserIDCompanyID(userID, userExperienceInput.CompanyID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Experience already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Experience already added")
 		return
 	}
 
@@ -76,13 +84,12 @@ func CreateUserExperience(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserExperience, err := userExperienceRepo.Create(&newUserExperienceData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user experience"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user experience")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserExperience.ID,
 			},
 		}
@@ -91,6 +98,88 @@ func CreateUserExperience(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user Experience godoc
+// @Summary Update User Experience
+// @Description Update user Experience
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param company_id path int true "Company ID"
+// @Param input body models.UserExperienceEditForm true "User experience input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-experience/{company_id} [patch]
+func UpdateUserExperience(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	companyIDStr, ok := vars["company_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not provided")
+		return
+	}
+
+	var userExperienceInput models.UserExperienceEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userExperienceInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user experience input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userExperienceInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userExperienceRepo := repositories.NewUserExperienceRepository()
+	companyID := helper.ParseIDStringToInt(companyIDStr)
+	userExperience, err := userExperienceRepo.ReadByUserIDCompanyID(userID, companyID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Experience ID not found")
+		return
+	}
+
+	if userExperienceInput.MonthStart != nil {
+		userExperience.MonthStart = *userExperienceInput.MonthStart
+	}
+	if userExperienceInput.MonthEnd != nil {
+		userExperience.MonthEnd = *userExperienceInput.MonthEnd
+	}
+	if userExperienceInput.YearStart != nil {
+		userExperience.YearStart = uint(*userExperienceInput.YearStart)
+	}
+	if userExperienceInput.YearEnd != nil {
+		userExperience.YearEnd = uint(*userExperienceInput.YearEnd)
+	}
+	if userExperienceInput.IsActive != nil {
+		userExperience.IsActive = *userExperienceInput.IsActive
+	}
+
+	// period is checked after merge, one side of the period can be kept from current data
+	if fieldErrors := helper.ValidatePeriod(userExperience.MonthStart, int64(userExperience.YearStart), userExperience.MonthEnd, int64(userExperience.YearEnd)); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	if _, err := userExperienceRepo.Update(userExperience); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user experience")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user Experience godoc
 // @Summary Delete User Experience
 // @Description Delete user Experience
@@ -98,33 +187,35 @@ func CreateUserExperience(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param company_id path int true "Company ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-experience/{company_id} [delete]
 func DeleteUserExperience(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	companyIDStr, ok := vars["company_id"]
 	if !ok {
-		response := map[string]string{"message": "Company ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not provided")
 		return
 	}
 
 	userExperienceRepo := repositories.NewUserExperienceRepository()
 	companyID := helper.ParseIDStringToInt(companyIDStr)
-	err := userExperienceRepo.DeleteByUserIDCompanyID(userID, companyID)
+	err = userExperienceRepo.DeleteByUserIDCompanyID(userID, companyID)
 	if err != nil {
-		response := map[string]string{"message": "User Experience ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Experience ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserExperienceTranslationCreateForm true "User experience input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-experience-translation [post]
 func CreateUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userExperienceTranslationInput models.UserExperienceTranslationCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userExperienceTranslationInput); err != nil {
-		log.Fatal("Error decoding new user experience: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user experience translation input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userExperienceTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	languageRepo := repositories.NewLanguageRepository()
 	userExperienceRepo := repositories.NewUserExperienceRepository()
 	userExperienceTranslationRepo := repositories.NewUserExperienceTranslationRepository()
@@ -41,8 +52,7 @@ func CreateUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
 	_, language_err := languageRepo.Read(userExperienceTranslationInput.LanguageID)
 	if language_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not found")
 		return
 	}
 
@@ -50,16 +60,14 @@ func CreateUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
 	userExperience, user_experience_err := userExperienceRepo.ReadByUserIDCompanyID(userID, userExperienceTranslationInput.CompanyID)
 	if user_experience_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Experience not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Experience not found")
 		return
 	}
 
 	exist_data, _ := userExperienceTranslationRepo.ReadByLanguageIDUserExperienceID(userExperienceTranslationInput.LanguageID, userExperience.ID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Experience already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Experience already added")
 		return
 	}
 
@@ -77,13 +85,12 @@ func CreateUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserExperienceTranslation, err := userExperienceTranslationRepo.Create(&newUserExperienceTranslationData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user experience translation"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user experience translation")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserExperienceTranslation.ID,
 			},
 		}
@@ -92,6 +99,103 @@ func CreateUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user Experience Translation godoc
+// @Summary Update User Experience Translation
+// @Description Update user Experience Translation, only provided fields are changed
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param company_id path int true "Company ID"
+// @Param language_id path int true "Language ID"
+// @Param input body models.UserExperienceTranslationEditForm true "User experience input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-experience-translation/{company_id}/{language_id} [put]
+// @Router /user-experience-translation/{company_id}/{language_id} [patch]
+func UpdateUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	companyIDStr, ok := vars["company_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not provided")
+		return
+	}
+
+	languageIDStr, ok := vars["language_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	var userExperienceTranslationInput models.UserExperienceTranslationEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userExperienceTranslationInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user experience translation input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userExperienceTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userExperienceRepo := repositories.NewUserExperienceRepository()
+	userExperienceTranslationRepo := repositories.NewUserExperienceTranslationRepository()
+	companyID := helper.ParseIDStringToInt(companyIDStr)
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+
+	userExperience, user_experience_err := userExperienceRepo.ReadByUserIDCompanyID(userID, companyID)
+	if user_experience_err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "Experience not found")
+		return
+	}
+
+	userExperienceTranslation, err := userExperienceTranslationRepo.ReadByLanguageIDUserExperienceID(languageID, userExperience.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Experience Translation ID not found")
+		return
+	}
+
+	if userExperienceTranslationInput.Title != nil {
+		userExperienceTranslation.Title = *userExperienceTranslationInput.Title
+	}
+	if userExperienceTranslationInput.Description != nil {
+		userExperienceTranslation.Description = *userExperienceTranslationInput.Description
+	}
+	if userExperienceTranslationInput.Category != nil {
+		userExperienceTranslation.Category = *userExperienceTranslationInput.Category
+	}
+	if userExperienceTranslationInput.Location != nil {
+		userExperienceTranslation.Location = *userExperienceTranslationInput.Location
+	}
+	if userExperienceTranslationInput.LocationType != nil {
+		userExperienceTranslation.LocationType = *userExperienceTranslationInput.LocationType
+	}
+	if userExperienceTranslationInput.Industry != nil {
+		userExperienceTranslation.Industry = *userExperienceTranslationInput.Industry
+	}
+
+	if _, err := userExperienceTranslationRepo.Update(userExperienceTranslation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user experience translation")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user Experience Translation godoc
 // @Summary Delete User Experience Translation
 // @Description Delete user Experience Translation
@@ -100,26 +204,28 @@ func CreateUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param company_id path int true "company ID"
 // @Param language_id path int true "Language ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-experience-translation/{company_id}/{language_id} [delete]
 func DeleteUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	companyIDStr, ok := vars["company_id"]
 	if !ok {
-		response := map[string]string{"message": "Company ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Company ID not provided")
 		return
 	}
 
 	languageIDStr, ok := vars["language_id"]
 	if !ok {
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
 		return
 	}
 
@@ -131,20 +237,18 @@ func DeleteUserExperienceTranslation(w http.ResponseWriter, r *http.Request) {
 	userExperience, user_experience_err := userExperienceRepo.ReadByUserIDCompanyID(userID, companyID)
 	if user_experience_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Experience not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Experience not found")
 		return
 	}
 
-	err := userExperienceTranslationRepo.DeleteByLanguageIDUserExperienceID(languageID, userExperience.ID)
+	err = userExperienceTranslationRepo.DeleteByLanguageIDUserExperienceID(languageID, userExperience.ID)
 	if err != nil {
-		response := map[string]string{"message": "User Experience Translation ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Experience Translation ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -2,9 +2,7 @@ package handlers
 
 import (
 	"encoding/json"
-	"math"
 	"net/http"
-	"time"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
@@ -14,6 +12,15 @@ import (
 
 var messageUserIdDetailNotFound = "User ID not found in URL"
 
+// owner can only manage their own account, admin can manage every account
+func isAllowedManageUser(r *http.Request, userID int64) bool {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		return false
+	}
+	return jwtClaim.Id == userID || jwtClaim.Role == models.UserRoleAdmin
+}
+
 // get all user godoc
 // @Summary Get All User
 // @Description Get All User with the pagination need login
@@ -22,72 +29,68 @@ var messageUserIdDetailNotFound = "User ID not found in URL"
 // @Produce json
 // @Param size query int false "Page size" default(5)
 // @Param offset query int false "Page offset" default(1)
+// @Param cursor query string false "Cursor from next_cursor or prev_cursor, empty value start cursor pagination without total count"
 // @Param name query string false "Filter by name"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 400 {object} map[string]string "Bad Request"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user [get]
 func GetFilteredPaginatedUsers(w http.ResponseWriter, r *http.Request) {
 	nameFilter := r.URL.Query().Get("name")
-	pageSize := helper.ParsePageSize(r.URL.Query().Get("size"))
-	pageNumber := helper.ParsePageNumber(r.URL.Query().Get("offset"))
-
-	userRepo := repositories.NewUserRepository()
-	totalCount, err := userRepo.Count(nameFilter)
+	pagination, err := helper.ParsePagination(r, "id", helper.SortOrderAsc)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch total count"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, err.Error())
 		return
 	}
 
-	totalPage := int(math.Ceil(float64(totalCount) / float64(pageSize)))
+	userRepo := repositories.NewUserRepository()
+	// cursor page skip total count
+	totalCount := 0
+	if !pagination.CursorMode {
+		totalCount, err = userRepo.Count(nameFilter)
+		if err != nil {
+			helper.ResponseDatabaseError(w, err, "Failed to fetch total count")
+			return
+		}
+	}
 
-	users, err := userRepo.ReadFilteredPaginated(nameFilter, pageSize, pageNumber)
+	users, err := userRepo.ReadFilteredPaginated(nameFilter, pagination)
 	if err != nil {
-		response := map[string]string{"message": "Failed to fetch users"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseDatabaseError(w, err, "Failed to fetch users")
 		return
 	}
 
-	var filteredUsers []struct {
-		ID        int64     `json:"id"`
-		Username  string    `json:"username"`
-		Name      string    `json:"name"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at"`
+	var nextCursor, prevCursor *string
+	if pagination.CursorMode {
+		users, nextCursor, prevCursor, err = helper.BuildCursorPage(users, pagination)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Failed to build cursor")
+			return
+		}
 	}
+
+	var filteredUsers []models.UserAllResponse
 	for _, user := range users {
-		filteredUsers = append(filteredUsers, struct {
-			ID        int64     `json:"id"`
-			Username  string    `json:"username"`
-			Name      string    `json:"name"`
-			CreatedAt time.Time `json:"created_at"`
-			UpdatedAt time.Time `json:"updated_at"`
-		}{
+		filteredUsers = append(filteredUsers, models.UserAllResponse{
 			ID:        user.ID,
 			Username:  user.Username,
 			Name:      user.Name,
+			Role:      user.Role,
 			CreatedAt: user.CreatedAt,
 			UpdatedAt: user.UpdatedAt,
 		})
 	}
 
 	if filteredUsers == nil {
-		response := map[string]string{"message": "user not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "user not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data":    filteredUsers,
-		"meta": map[string]interface{}{
-			"size":       pageSize,
-			"offset":     pageNumber,
-			"totalCount": totalCount,
-			"totalPage":  totalPage,
-		},
+	response := helper.Response{
+		Message: "success",
+		Data:    filteredUsers,
+		Meta:    helper.PageMeta(pagination, totalCount, nextCursor, prevCursor),
 	}
 
 	helper.ResponseJSON(w, http.StatusOK, response)
@@ -100,36 +103,42 @@ func GetFilteredPaginatedUsers(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Success 403 {object} helper.Response "Forbidden"
 // @Router /user/{id} [get]
 func GetUser(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": messageUserIdDetailNotFound}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, messageUserIdDetailNotFound)
 		return
 	}
 
 	userRepo := repositories.NewUserRepository()
 	userID := helper.ParseIDStringToInt(userIDStr)
+	if !isAllowedManageUser(r, userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing read")
+		return
+	}
+
 	user, err := userRepo.Read(userID)
 	if err != nil {
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
-		"data": map[string]interface{}{
-			"id":         user.ID,
-			"username":   user.Username,
-			"name":       user.Name,
-			"created_at": user.CreatedAt,
-			"updated_at": user.UpdatedAt,
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"id":                  user.ID,
+			"username":            user.Username,
+			"name":                user.Name,
+			"role":                user.Role,
+			"default_language_id": user.DefaultLanguageID,
+			"created_at":          user.CreatedAt,
+			"updated_at":          user.UpdatedAt,
 		},
 	}
 
@@ -144,47 +153,64 @@ func GetUser(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param id path int true "User ID"
 // @Param input body models.UserEditForm true "User input"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user/{id} [put]
 func UpdateUser(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": messageUserIdDetailNotFound}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, messageUserIdDetailNotFound)
 		return
 	}
 
 	userID := helper.ParseIDStringToInt(userIDStr)
+	if !isAllowedManageUser(r, userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
+		return
+	}
 
 	var updatedUser models.UserEditForm
 	if err := json.NewDecoder(r.Body).Decode(&updatedUser); err != nil {
-		response := map[string]string{"message": "Failed to decode user input"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user input")
 		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(updatedUser); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	userRepo := repositories.NewUserRepository()
 	// validate username unique in other user
 	exist_user, _ := userRepo.ReadByUsername(updatedUser.Username)
 	if exist_user != nil && exist_user.ID != userID {
 		// Handle error
-		response := map[string]string{"message": "Username already used"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Username already used")
 		return
 	}
 
+	// validate default language exist
+	if updatedUser.DefaultLanguageID != nil && *updatedUser.DefaultLanguageID != 0 {
+		languageRepo := repositories.NewLanguageRepository()
+		if _, err := languageRepo.Read(*updatedUser.DefaultLanguageID); err != nil {
+			helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Default language not found")
+			return
+		}
+	}
+
 	if err := userRepo.Update(userID, &updatedUser); err != nil {
-		response := map[string]string{"message": "Failed to update user"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Failed to update user")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -196,30 +222,168 @@ func UpdateUser(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 409 {object} helper.Response "Conflict"
 // @Router /user/{id} [delete]
 func DeleteUser(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	userIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": messageUserIdDetailNotFound}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, messageUserIdDetailNotFound)
 		return
 	}
 
 	userRepo := repositories.NewUserRepository()
 	userID := helper.ParseIDStringToInt(userIDStr)
-	err := userRepo.Delete(userID)
+	if !isAllowedManageUser(r, userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing delete")
+		return
+	}
+
+	user, err := userRepo.Read(userID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User ID not found")
+		return
+	}
+	if !checkNotLastAdmin(w, userRepo, user, "Can not delete the last admin") {
+		return
+	}
+
+	if err := userRepo.Delete(userID); err != nil {
+		helper.ResponseDatabaseError(w, err, "User ID not found")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// there must always be an admin left to manage users, return false when the user is the last admin and response already written
+func checkNotLastAdmin(w http.ResponseWriter, userRepo *repositories.UserRepository, user *models.User, message string) bool {
+	if user.Role != models.UserRoleAdmin {
+		return true
+	}
+
+	totalAdmin, err := userRepo.CountByRole(models.UserRoleAdmin)
 	if err != nil {
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "Failed to count admin")
+		return false
+	}
+	if totalAdmin <= 1 {
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, message)
+		return false
+	}
+	return true
+}
+
+// update user role godoc
+// @Summary Update User Role
+// @Description Update role of user with provided id, admin only
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param input body models.UserRoleEditForm true "User role input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user/{id}/role [patch]
+func UpdateUserRole(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, messageUserIdDetailNotFound)
+		return
+	}
+
+	userID := helper.ParseIDStringToInt(userIDStr)
+
+	var updatedRole models.UserRoleEditForm
+	if err := json.NewDecoder(r.Body).Decode(&updatedRole); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user role input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(updatedRole); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	if updatedRole.Role != models.UserRoleAdmin && updatedRole.Role != models.UserRoleOwner {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Role must be admin or owner")
+		return
+	}
+
+	userRepo := repositories.NewUserRepository()
+	user, err := userRepo.Read(userID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User ID not found")
+		return
+	}
+
+	if updatedRole.Role != models.UserRoleAdmin && !checkNotLastAdmin(w, userRepo, user, "Can not change role of the last admin") {
+		return
+	}
+
+	if err := userRepo.UpdateRole(userID, updatedRole.Role); err != nil {
+		helper.ResponseDatabaseError(w, err, "User ID not found")
+		return
+	}
+
+	// token issued before still carry the old role
+	if user.Role != updatedRole.Role && !revokeUserSessions(w, userID) {
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// delete user sessions godoc
+// @Summary Delete User Sessions
+// @Description Revoke every refresh token and access token of user with provided id, admin only
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /user/{id}/session [delete]
+func DeleteUserSessions(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	userIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, messageUserIdDetailNotFound)
+		return
+	}
+
+	userRepo := repositories.NewUserRepository()
+	userID := helper.ParseIDStringToInt(userIDStr)
+	if _, err := userRepo.Read(userID); err != nil {
+		helper.ResponseDatabaseError(w, err, "User ID not found")
+		return
+	}
+
+	refreshTokenRepo := repositories.NewUserRefreshTokenRepository()
+	if err := refreshTokenRepo.RevokeByUserID(userID); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to revoke user sessions")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserLanguageCreateForm true "User Language input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-language [post]
 func CreateUserLanguage(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userLanguageInput models.UserLanguageCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userLanguageInput); err != nil {
-		log.Fatal("Error decoding new user language: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user language input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userLanguageInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	userRepo := repositories.NewUserRepository()
 	languageRepo := repositories.NewLanguageRepository()
 	userLanguageRepo := repositories.NewUserLanguageRepository()
@@ -41,8 +52,7 @@ func CreateUserLanguage(w http.ResponseWriter, r *http.Request) {
 	_, user_err := userRepo.Read(userID)
 	if user_err != nil {
 		// Handle error
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User ID not found")
 		return
 	}
 
@@ -50,8 +60,7 @@ func CreateUserLanguage(w http.ResponseWriter, r *http.Request) {
 	_, lang_err := languageRepo.Read(userLanguageInput.LanguageID)
 	if lang_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Lang ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Lang ID not found")
 		return
 	}
 
@@ -59,8 +68,7 @@ func CreateUserLanguage(w http.ResponseWriter, r *http.Request) {
 	exist_data, _ := userLanguageRepo.ReadByUserIDLanguageID(userID, userLanguageInput.LanguageID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Language already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Language already added")
 		return
 	}
 
@@ -72,13 +80,12 @@ func CreateUserLanguage(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserLanguage, err := userLanguageRepo.Create(&newUserLanguageData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user language"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user language")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserLanguage.ID,
 			},
 		}
@@ -87,6 +94,70 @@ func CreateUserLanguage(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user language godoc
+// @Summary Update User language
+// @Description Update user language
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param language_id path int true "Language ID"
+// @Param input body models.UserLanguageEditForm true "User language input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-language/{language_id} [patch]
+func UpdateUserLanguage(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	languageIDStr, ok := vars["language_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	var userLanguageInput models.UserLanguageEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userLanguageInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user language input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userLanguageInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userLanguageRepo := repositories.NewUserLanguageRepository()
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+	userLanguage, err := userLanguageRepo.ReadByUserIDLanguageID(userID, languageID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Language ID not found")
+		return
+	}
+
+	if userLanguageInput.IsActive != nil {
+		userLanguage.IsActive = *userLanguageInput.IsActive
+	}
+
+	if _, err := userLanguageRepo.Update(userLanguage); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user language")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user language godoc
 // @Summary Delete User language
 // @Description Delete user language
@@ -94,33 +165,35 @@ func CreateUserLanguage(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param language_id path int true "Language ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-language/{language_id} [delete]
 func DeleteUserLanguage(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	languageIDStr, ok := vars["language_id"]
 	if !ok {
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
 		return
 	}
 
 	userLanguageRepo := repositories.NewUserLanguageRepository()
 	languageID := helper.ParseIDStringToInt(languageIDStr)
-	err := userLanguageRepo.DeleteByUserIDLanguageID(userID, languageID)
+	err = userLanguageRepo.DeleteByUserIDLanguageID(userID, languageID)
 	if err != nil {
-		response := map[string]string{"message": "User Language ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Language ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
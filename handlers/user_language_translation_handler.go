@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserLanguageTranslationCreateForm true "User Language input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-language-translation [post]
 func CreateUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userLanguageTranslationInput models.UserLanguageTranslationCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userLanguageTranslationInput); err != nil {
-		log.Fatal("Error decoding new user language: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user language translation input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userLanguageTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	languageRepo := repositories.NewLanguageRepository()
 	userLanguageRepo := repositories.NewUserLanguageRepository()
 	userLanguageTranslationRepo := repositories.NewUserLanguageTranslationRepository()
@@ -41,8 +52,7 @@ func CreateUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
 	_, language_err := languageRepo.Read(userLanguageTranslationInput.LanguageID)
 	if language_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not found")
 		return
 	}
 
@@ -50,16 +60,14 @@ func CreateUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
 	userLanguage, user_language_err := userLanguageRepo.ReadByUserIDLanguageID(userID, userLanguageTranslationInput.SelectLanguageID)
 	if user_language_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Select Language not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Select Language not found")
 		return
 	}
 
 	exist_data, _ := userLanguageTranslationRepo.ReadByLanguageIDUserLanguageID(userLanguageTranslationInput.LanguageID, userLanguage.ID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Language already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Language already added")
 		return
 	}
 
@@ -73,13 +81,12 @@ func CreateUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserLanguageTranslation, err := userLanguageTranslationRepo.Create(&newUserLanguageTranslationData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user language translation"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user language translation")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserLanguageTranslation.ID,
 			},
 		}
@@ -88,6 +95,91 @@ func CreateUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user Language Translation godoc
+// @Summary Update User Language Translation
+// @Description Update user Language Translation, only provided fields are changed
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param select_language_id path int true "Select Language ID"
+// @Param language_id path int true "language ID"
+// @Param input body models.UserLanguageTranslationEditForm true "User Language input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-language-translation/{select_language_id}/{language_id} [put]
+// @Router /user-language-translation/{select_language_id}/{language_id} [patch]
+func UpdateUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	languageIDStr, ok := vars["language_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
+		return
+	}
+
+	selectLanguageIDStr, ok := vars["select_language_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Select Language ID not provided")
+		return
+	}
+
+	var userLanguageTranslationInput models.UserLanguageTranslationEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userLanguageTranslationInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user language translation input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userLanguageTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userLanguageRepo := repositories.NewUserLanguageRepository()
+	userLanguageTranslationRepo := repositories.NewUserLanguageTranslationRepository()
+	languageID := helper.ParseIDStringToInt(languageIDStr)
+	selectLanguageID := helper.ParseIDStringToInt(selectLanguageIDStr)
+
+	userLanguage, user_language_err := userLanguageRepo.ReadByUserIDLanguageID(userID, selectLanguageID)
+	if user_language_err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusNotFound, helper.ErrorCodeNotFound, "Select Language not found")
+		return
+	}
+
+	userLanguageTranslation, err := userLanguageTranslationRepo.ReadByLanguageIDUserLanguageID(languageID, userLanguage.ID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Language Translation ID not found")
+		return
+	}
+
+	if userLanguageTranslationInput.Title != nil {
+		userLanguageTranslation.Title = *userLanguageTranslationInput.Title
+	}
+	if userLanguageTranslationInput.Description != nil {
+		userLanguageTranslation.Description = *userLanguageTranslationInput.Description
+	}
+
+	if _, err := userLanguageTranslationRepo.Update(userLanguageTranslation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user language translation")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user Language Translation godoc
 // @Summary Delete User Language Translation
 // @Description Delete user Language Translation
@@ -96,26 +188,28 @@ func CreateUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
 // @Produce json
 // @Param select_language_id path int true "Select Language ID"
 // @Param language_id path int true "language ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-language-translation/{select_language_id}/{language_id} [delete]
 func DeleteUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	languageIDStr, ok := vars["language_id"]
 	if !ok {
-		response := map[string]string{"message": "Language ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language ID not provided")
 		return
 	}
 
 	selectLanguageIDStr, ok := vars["select_language_id"]
 	if !ok {
-		response := map[string]string{"message": "Select Language ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Select Language ID not provided")
 		return
 	}
 
@@ -128,28 +222,25 @@ func DeleteUserLanguageTranslation(w http.ResponseWriter, r *http.Request) {
 	_, language_err := languageRepo.Read(selectLanguageID)
 	if language_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Select Language not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Select Language not found")
 		return
 	}
 
 	userLanguage, user_language_err := userLanguageRepo.ReadByUserIDLanguageID(userID, selectLanguageID)
 	if user_language_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Language not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Language not found")
 		return
 	}
 
-	err := userLanguageTranslationRepo.DeleteByLanguageIDUserLanguageID(languageID, userLanguage.ID)
+	err = userLanguageTranslationRepo.DeleteByLanguageIDUserLanguageID(languageID, userLanguage.ID)
 	if err != nil {
-		response := map[string]string{"message": "User Language Translation ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Language Translation ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserPositionCreateForm true "User position input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-position [post]
 func CreateUserPosition(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userPositionInput models.UserPositionCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userPositionInput); err != nil {
-		log.Fatal("Error decoding new user position: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user position input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userPositionInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	userRepo := repositories.NewUserRepository()
 	userPositionRepo := repositories.NewUserPositionRepository()
 
@@ -40,8 +51,7 @@ func CreateUserPosition(w http.ResponseWriter, r *http.Request) {
 	_, user_err := userRepo.Read(userID)
 	if user_err != nil {
 		// Handle error
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User ID not found")
 		return
 	}
 
@@ -53,13 +63,12 @@ func CreateUserPosition(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserPosition, err := userPositionRepo.Create(&newUserPositionData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user position"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user position")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserPosition.ID,
 			},
 		}
@@ -68,6 +77,80 @@ func CreateUserPosition(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user position godoc
+// @Summary Update User position
+// @Description Update user position
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User Position ID"
+// @Param input body models.UserPositionEditForm true "User position input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-position/{id} [patch]
+func UpdateUserPosition(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	userPositionIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Position not provided")
+		return
+	}
+
+	var userPositionInput models.UserPositionEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userPositionInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user position input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userPositionInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userPositionRepo := repositories.NewUserPositionRepository()
+	userPositionID := helper.ParseIDStringToInt(userPositionIDStr)
+
+	userPosition, err := userPositionRepo.Read(userPositionID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Position ID not found")
+		return
+	}
+
+	if userPosition.UserID != uint(userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
+		return
+	}
+
+	if userPositionInput.Title != nil {
+		userPosition.Title = *userPositionInput.Title
+	}
+	if userPositionInput.IsActive != nil {
+		userPosition.IsActive = *userPositionInput.IsActive
+	}
+
+	if _, err := userPositionRepo.Update(userPosition); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user position")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user position godoc
 // @Summary Delete User position
 // @Description Delete user position
@@ -75,20 +158,23 @@ func CreateUserPosition(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User Position ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Success 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-position/{id} [delete]
 func DeleteUserPosition(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	userRepoIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": "User Position not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Position not provided")
 		return
 	}
 
@@ -97,26 +183,23 @@ func DeleteUserPosition(w http.ResponseWriter, r *http.Request) {
 
 	userPosition, err := userPositionRepo.Read(userPositionID)
 	if err != nil {
-		response := map[string]string{"message": "User Position ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Position ID not found")
 		return
 	}
 
 	if userPosition.UserID != uint(userID) {
-		response := map[string]string{"message": "Not allowing delete"}
-		helper.ResponseJSON(w, http.StatusForbidden, response)
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing delete")
 		return
 	}
 
 	errDelete := userPositionRepo.Delete(userPositionID)
 	if errDelete != nil {
-		response := map[string]string{"message": "User Position ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, errDelete, "User Position ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -2,12 +2,12 @@ package handlers
 
 import (
 	"net/http"
-	"os"
-	"path/filepath"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -24,18 +24,23 @@ import (
 // @Param url formData string false "User attachment URL"
 // @Param is_external_url formData bool false "Is external URL"
 // @Param is_external_image_url formData bool false "Is external image URL"
-// @Success 201 {object} map[string]string "Created"
-// @Success 403 {object} map[string]string "Forbidden"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-project-attachment [post]
 func CreateUserProjectAttachment(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 
-	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	err = r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
@@ -48,66 +53,65 @@ func CreateUserProjectAttachment(w http.ResponseWriter, r *http.Request) {
 	userProjectIDStr := r.FormValue("user_project_id")
 	userProjectID := helper.ParseIDStringToInt(userProjectIDStr)
 
+	// Create a new user project record
+	newUserProjectAttachmentData := models.UserProjectAttachment{
+		UserProjectID:      uint(userProjectID),
+		Title:              title,
+		Category:           category,
+		Url:                url,
+		IsExternalUrl:      isExternalUrl,
+		IsExternalImageUrl: isExternalImageUrl,
+	}
+	if fieldErrors := helper.ValidateStruct(newUserProjectAttachmentData); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("image_file")
+	file, _, err := r.FormFile("image_file")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	userProjectRepo := repositories.NewUserProjectRepository()
-	userProjectAttachmentRepo := repositories.NewUserProjectAttachmentRepository()
 	// validation name and code unique
 	userProject, errUserProject := userProjectRepo.Read(userProjectID)
 	if errUserProject != nil {
-		response := map[string]string{"message": "User Project ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, errUserProject, "User Project ID not found")
 		return
 	}
 
 	if userProject.UserID != uint(userID) {
-		response := map[string]string{"message": "Not allowing create"}
-		helper.ResponseJSON(w, http.StatusForbidden, response)
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing create")
 		return
 	}
 
-	// validation location image
-	var directory = "./assets/images/user/project/showcase_attachment"
-	if err := os.MkdirAll(directory, 0755); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryUserProjectAttachment)
+	if !ok {
 		return
 	}
+	newUserProjectAttachmentData.ImageUrl = imageUrl
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	finalFilename := helper.GetStringTimeNow() + "_" + helper.ParseIDIntToString(userID) + "_" + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
-
-	// Create a new user project record
-	newUserProjectAttachmentData := models.UserProjectAttachment{
-		UserProjectID:      uint(userProjectID),
-		Title:              title,
-		Category:           category,
-		ImageUrl:           imageUrl,
-		Url:                url,
-		IsExternalUrl:      isExternalUrl,
-		IsExternalImageUrl: isExternalImageUrl,
-	}
 	// insert to database
-	if newUserProjectAttachment, err := userProjectAttachmentRepo.Create(&newUserProjectAttachmentData); err != nil {
+	if newUserProjectAttachment, err := unitOfWork.UserProjectAttachmentRepository().Create(&newUserProjectAttachmentData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user project attachment"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user project attachment")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new user project attachment")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserProjectAttachment.ID,
 			},
 		}
@@ -116,6 +120,126 @@ func CreateUserProjectAttachment(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// UpdateUserProjectAttachment godoc
+// @Summary Update user project attachment
+// @Description Update user project attachment with optional file replacement, only provided fields are changed
+// @Tags users
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "User Project Attachment ID"
+// @Param title formData string false "User attachment title"
+// @Param category formData string false "User attachment category"
+// @Param image_file formData file false "User attachment image file"
+// @Param url formData string false "User attachment URL"
+// @Param is_external_url formData bool false "Is external URL"
+// @Param is_external_image_url formData bool false "Is external image URL"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-project-attachment/{id} [patch]
+func UpdateUserProjectAttachment(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	userProjectAttachmentIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project Attachment not provided")
+		return
+	}
+
+	err = r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
+		// Handle error
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
+		return
+	}
+
+	userProjectRepo := repositories.NewUserProjectRepository()
+	userProjectAttachmentRepo := repositories.NewUserProjectAttachmentRepository()
+	userProjectAttachmentID := helper.ParseIDStringToInt(userProjectAttachmentIDStr)
+
+	userProjectAttachment, err := userProjectAttachmentRepo.Read(userProjectAttachmentID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project Attachment ID not found")
+		return
+	}
+
+	userProject, err := userProjectRepo.Read(int64(userProjectAttachment.UserProjectID))
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
+		return
+	}
+
+	if userProject.UserID != uint(userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
+		return
+	}
+
+	if r.PostForm.Has("title") {
+		userProjectAttachment.Title = r.FormValue("title")
+	}
+	if r.PostForm.Has("category") {
+		userProjectAttachment.Category = r.FormValue("category")
+	}
+	if r.PostForm.Has("url") {
+		userProjectAttachment.Url = r.FormValue("url")
+	}
+	if r.PostForm.Has("is_external_url") {
+		userProjectAttachment.IsExternalUrl = helper.ParseIDStringToBool(r.FormValue("is_external_url"))
+	}
+	if r.PostForm.Has("is_external_image_url") {
+		userProjectAttachment.IsExternalImageUrl = helper.ParseIDStringToBool(r.FormValue("is_external_image_url"))
+	}
+
+	if fieldErrors := helper.ValidateStruct(userProjectAttachment); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := userProjectAttachment.ImageUrl
+	file, _, err := r.FormFile("image_file")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryUserProjectAttachment)
+		if !ok {
+			return
+		}
+		userProjectAttachment.ImageUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.UserProjectAttachmentRepository().Update(userProjectAttachment); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user project attachment")
+		return
+	}
+
+	if storages.KeyFromPath(userProjectAttachment.ImageUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user project attachment")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user project attachment godoc
 // @Summary Delete User project attachment
 // @Description Delete user project attachment
@@ -123,20 +247,23 @@ func CreateUserProjectAttachment(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User Project Attachment ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Success 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-project-attachment/{id} [delete]
 func DeleteUserProjectAttachment(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	userProjectAttachmentIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": "User Project Attachment not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project Attachment not provided")
 		return
 	}
 
@@ -146,41 +273,39 @@ func DeleteUserProjectAttachment(w http.ResponseWriter, r *http.Request) {
 
 	userProjectAttachment, err := userProjectAttachmentRepo.Read(userProjectAttachmentID)
 	if err != nil {
-		response := map[string]string{"message": "User Project Attachment ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Project Attachment ID not found")
 		return
 	}
 
 	userProject, err := userProjectRepo.Read(int64(userProjectAttachment.UserProjectID))
 	if err != nil {
-		response := map[string]string{"message": "User Project ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
 		return
 	}
 
 	if userProject.UserID != uint(userID) {
-		response := map[string]string{"message": "Not allowing delete"}
-		helper.ResponseJSON(w, http.StatusForbidden, response)
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing delete")
 		return
 	}
 
-	errDeleteImage := helper.RemoveFile(userProjectAttachment.ImageUrl)
-	if errDeleteImage != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to delete file"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	errDelete := unitOfWork.UserProjectAttachmentRepository().Delete(userProjectAttachmentID)
+	if errDelete != nil {
+		helper.ResponseDatabaseError(w, errDelete, "User Project Attachment ID not found")
 		return
 	}
 
-	errDelete := userProjectAttachmentRepo.Delete(userProjectAttachmentID)
-	if errDelete != nil {
-		response := map[string]string{"message": "User Project Attachment ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+	storages.DeleteImage(unitOfWork.Files, userProjectAttachment.ImageUrl)
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete user project attachment")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
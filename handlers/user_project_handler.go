@@ -2,13 +2,13 @@ package handlers
 
 import (
 	"net/http"
-	"os"
-	"path/filepath"
 	"time"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
 	"github.com/gorilla/mux"
 )
 
@@ -23,17 +23,22 @@ import (
 // @Param project_updated_at formData string true "User project updated at"
 // @Param slug formData string true "User project slug"
 // @Param image_file formData file true "User project image file"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-project [post]
 func CreateUserProject(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 
-	err := r.ParseMultipartForm(10 << 20) // 10MB max size
+	err = r.ParseMultipartForm(10 << 20) // 10MB max size
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Max size file only 10 mb"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
@@ -44,88 +49,223 @@ func CreateUserProject(w http.ResponseWriter, r *http.Request) {
 
 	projectCreatedAt := r.FormValue("project_created_at")
 	projectUpdatedAt := r.FormValue("project_updated_at")
+
+	// date parse error is reported together with the other field errors
+	var fieldErrors []helper.FieldError
+	parsedCreatedAt, err := time.Parse(time.RFC3339, projectCreatedAt)
+	if err != nil {
+		fieldErrors = append(fieldErrors, helper.FieldError{Field: "project_created_at", Code: helper.FieldErrorInvalidDate, Message: "project_created_at must be RFC3339 date time"})
+	}
+	parsedUpdatedAt, err := time.Parse(time.RFC3339, projectUpdatedAt)
+	if err != nil {
+		fieldErrors = append(fieldErrors, helper.FieldError{Field: "project_updated_at", Code: helper.FieldErrorInvalidDate, Message: "project_updated_at must be RFC3339 date time"})
+	}
+
+	// Create a new user project record
+	newUserProjectData := models.UserProject{
+		ProjectPlatformID: uint(projectPlatformID),
+		UserID:            uint(userID),
+		Slug:              slug,
+		ProjectCreatedAt:  parsedCreatedAt,
+		ProjectUpdatedAt:  parsedUpdatedAt,
+	}
+	fieldErrors = append(fieldErrors, helper.ValidateStruct(newUserProjectData)...)
+	if len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	// Get the uploaded file
-	file, header, err := r.FormFile("image_file")
+	file, _, err := r.FormFile("image_file")
 	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to get file"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Failed to get file")
 		return
 	}
 	defer file.Close()
 
 	userRepo := repositories.NewUserRepository()
 	projectPlatformRepo := repositories.NewProjectPlatformRepository()
-	userProjectRepo := repositories.NewUserProjectRepository()
 	// validation name and code unique
 	_, errProjectPlatform := projectPlatformRepo.Read(projectPlatformID)
 	if errProjectPlatform != nil {
-		response := map[string]string{"message": "Project Platfrom ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, errProjectPlatform, "Project Platform ID not found")
 		return
 	}
 
 	_, err_user := userRepo.Read(userID)
 	if err_user != nil {
 		// Handle error
-		response := map[string]string{"message": "User not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err_user, "User not found")
 		return
 	}
 
-	parsedCreatedAt, err := time.Parse(time.RFC3339, projectCreatedAt)
-	if err != nil {
-		response := map[string]string{"message": "User not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryUserProject)
+	if !ok {
 		return
 	}
+	newUserProjectData.ImageUrl = imageUrl
 
-	parsedUpdatedAt, err := time.Parse(time.RFC3339, projectUpdatedAt)
+	// insert to database
+	if newUserProject, err := unitOfWork.UserProjectRepository().Create(&newUserProjectData); err != nil {
+		// Handle error
+		helper.ResponseDatabaseError(w, err, "Error creating new user project")
+		return
+	} else {
+		if err := unitOfWork.Commit(); err != nil {
+			helper.ResponseDatabaseError(w, err, "Error creating new user project")
+			return
+		}
+
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
+				"id": newUserProject.ID,
+			},
+		}
+		helper.ResponseJSON(w, http.StatusOK, response)
+		return
+	}
+}
+
+// UpdateUserProject godoc
+// @Summary Update user project
+// @Description Update user project with optional file replacement, only provided fields are changed
+// @Tags users
+// @Accept mpfd
+// @Produce json
+// @Param id path int true "User Project ID"
+// @Param project_platform_id formData string false "project platform id"
+// @Param project_created_at formData string false "User project created at"
+// @Param project_updated_at formData string false "User project updated at"
+// @Param slug formData string false "User project slug"
+// @Param is_active formData bool false "Is active"
+// @Param image_file formData file false "User project image file"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-project/{id} [patch]
+func UpdateUserProject(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
 	if err != nil {
-		response := map[string]string{"message": "User not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	userProjectIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project not provided")
 		return
 	}
 
-	// validation location image
-	var directory = "./assets/images/user/project/showcase"
-	if err := os.MkdirAll(directory, 0755); err != nil {
+	err = r.ParseMultipartForm(10 << 20) // 10MB max size
+	if err != nil {
 		// Handle error
-		response := map[string]string{"message": "Failed to create directory"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Max size file only 10 mb")
 		return
 	}
 
-	filename := header.Filename
-	extension := filepath.Ext(filename)
-	finalFilename := helper.GetStringTimeNow() + "_" + helper.ParseIDIntToString(userID) + "_" + extension
-	imageUrl, _ := helper.UploadFile(file, directory, finalFilename)
+	userProjectRepo := repositories.NewUserProjectRepository()
+	projectPlatformRepo := repositories.NewProjectPlatformRepository()
+	userProjectID := helper.ParseIDStringToInt(userProjectIDStr)
 
-	// Create a new user project record
-	newUserProjectData := models.UserProject{
-		ProjectPlatformID: uint(projectPlatformID),
-		UserID:            uint(userID),
-		Slug:              slug,
-		ImageUrl:          imageUrl,
-		ProjectCreatedAt:  parsedCreatedAt,
-		ProjectUpdatedAt:  parsedUpdatedAt,
+	userProject, err := userProjectRepo.Read(userProjectID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
+		return
 	}
-	// insert to database
-	if newUserProject, err := userProjectRepo.Create(&newUserProjectData); err != nil {
-		// Handle error
-		response := map[string]string{"message": "Error creating new user project"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+
+	if userProject.UserID != uint(userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
 		return
-	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
-				"id": newUserProject.ID,
-			},
+	}
+
+	if r.PostForm.Has("project_platform_id") {
+		projectPlatformID := helper.ParseIDStringToInt(r.FormValue("project_platform_id"))
+		_, errProjectPlatform := projectPlatformRepo.Read(projectPlatformID)
+		if errProjectPlatform != nil {
+			helper.ResponseDatabaseError(w, errProjectPlatform, "Project Platform ID not found")
+			return
 		}
-		helper.ResponseJSON(w, http.StatusOK, response)
+		userProject.ProjectPlatformID = uint(projectPlatformID)
+	}
+
+	if r.PostForm.Has("slug") {
+		userProject.Slug = r.FormValue("slug")
+	}
+
+	// date parse error is reported together with the other field errors, same as create
+	var fieldErrors []helper.FieldError
+	if r.PostForm.Has("project_created_at") {
+		parsedCreatedAt, err := time.Parse(time.RFC3339, r.FormValue("project_created_at"))
+		if err != nil {
+			fieldErrors = append(fieldErrors, helper.FieldError{Field: "project_created_at", Code: helper.FieldErrorInvalidDate, Message: "project_created_at must be RFC3339 date time"})
+		} else {
+			userProject.ProjectCreatedAt = parsedCreatedAt
+		}
+	}
+
+	if r.PostForm.Has("project_updated_at") {
+		parsedUpdatedAt, err := time.Parse(time.RFC3339, r.FormValue("project_updated_at"))
+		if err != nil {
+			fieldErrors = append(fieldErrors, helper.FieldError{Field: "project_updated_at", Code: helper.FieldErrorInvalidDate, Message: "project_updated_at must be RFC3339 date time"})
+		} else {
+			userProject.ProjectUpdatedAt = parsedUpdatedAt
+		}
+	}
+
+	if r.PostForm.Has("is_active") {
+		userProject.IsActive = helper.ParseIDStringToBool(r.FormValue("is_active"))
+	}
+
+	fieldErrors = append(fieldErrors, helper.ValidateStruct(userProject)...)
+	if len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	// replace image when a new file is provided
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	oldImageUrl := userProject.ImageUrl
+	file, _, err := r.FormFile("image_file")
+	if err == nil {
+		defer file.Close()
+
+		imageUrl, ok := uploadImageFile(w, unitOfWork.Files, file, "image_file", imageDirectoryUserProject)
+		if !ok {
+			return
+		}
+		userProject.ImageUrl = imageUrl
+	}
+
+	if _, err := unitOfWork.UserProjectRepository().Update(userProject); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user project")
+		return
+	}
+
+	if storages.KeyFromPath(userProject.ImageUrl) != storages.KeyFromPath(oldImageUrl) && oldImageUrl != "" {
+		storages.DeleteImage(unitOfWork.Files, oldImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user project")
 		return
 	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
 }
 
 // delete user project godoc
@@ -135,20 +275,23 @@ func CreateUserProject(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User Project ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Success 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-project/{id} [delete]
 func DeleteUserProject(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	userRepoIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": "User Project not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project not provided")
 		return
 	}
 
@@ -157,34 +300,43 @@ func DeleteUserProject(w http.ResponseWriter, r *http.Request) {
 
 	userProject, err := userProjectRepo.Read(userProjectID)
 	if err != nil {
-		response := map[string]string{"message": "User Project ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
 		return
 	}
 
 	if userProject.UserID != uint(userID) {
-		response := map[string]string{"message": "Not allowing delete"}
-		helper.ResponseJSON(w, http.StatusForbidden, response)
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing delete")
 		return
 	}
 
-	errDeleteImage := helper.RemoveFile(userProject.ImageUrl)
-	if errDeleteImage != nil {
-		// Handle error
-		response := map[string]string{"message": "Failed to delete file"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+	unitOfWork := repositories.NewUnitOfWork(storages.NewStorage(config.GetStorageConfig()))
+	defer unitOfWork.Rollback()
+
+	// attachment rows are deleted by cascade, their files are deleted with the project
+	userProjectAttachments, err := unitOfWork.UserProjectAttachmentRepository().ReadAll(&userProjectID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch user project attachments")
 		return
 	}
 
-	errDelete := userProjectRepo.Delete(userProjectID)
+	errDelete := unitOfWork.UserProjectRepository().Delete(userProjectID)
 	if errDelete != nil {
-		response := map[string]string{"message": "User Project ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, errDelete, "User Project ID not found")
+		return
+	}
+
+	storages.DeleteImage(unitOfWork.Files, userProject.ImageUrl)
+	for _, userProjectAttachment := range userProjectAttachments {
+		storages.DeleteImage(unitOfWork.Files, userProjectAttachment.ImageUrl)
+	}
+
+	if err := unitOfWork.Commit(); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to delete user project")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+	"github.com/FRanggaY/personal-portfolio-api/translators"
+	"github.com/gorilla/mux"
+)
+
+// Machine Translate User Project godoc
+// @Summary Machine translate User Project
+// @Description Create draft translation marked machine_translated in every language which has no translation yet, translated from source language translation. Draft stay machine_translated until it is updated or approved
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User Project ID"
+// @Param input body models.UserProjectMachineTranslateForm true "Source language"
+// @Success 200 {object} helper.Response "Success"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-project/{id}/machine-translate [post]
+func MachineTranslateUserProject(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+
+	vars := mux.Vars(r)
+	userProjectIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project not provided")
+		return
+	}
+
+	var machineTranslateInput models.UserProjectMachineTranslateForm
+	if err := json.NewDecoder(r.Body).Decode(&machineTranslateInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode machine translate input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(machineTranslateInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userProjectRepo := repositories.NewUserProjectRepository()
+	userProjectTranslationRepo := repositories.NewUserProjectTranslationRepository()
+	languageRepo := repositories.NewLanguageRepository()
+
+	userProject, err := userProjectRepo.Read(helper.ParseIDStringToInt(userProjectIDStr))
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
+		return
+	}
+
+	if userProject.UserID != uint(jwtClaim.Id) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
+		return
+	}
+
+	sourceLanguage, err := languageRepo.Read(machineTranslateInput.SourceLanguageID)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Source language not found")
+		return
+	}
+
+	sourceTranslation, err := userProjectTranslationRepo.ReadByLanguageIDUserProjectID(sourceLanguage.ID, userProject.ID)
+	if err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Source translation not found")
+		return
+	}
+
+	languages, err := languageRepo.ReadAll()
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to fetch languages")
+		return
+	}
+
+	translator := translators.NewTranslator(config.GetTranslatorConfig())
+	nameMaxLength := helper.GetSizeLimit(models.UserProjectTranslation{}, "name")
+	descriptionMaxLength := helper.GetSizeLimit(models.UserProjectTranslation{}, "description")
+
+	created := []map[string]interface{}{}
+	failed := []map[string]interface{}{}
+	for _, language := range languages {
+		if language.ID == sourceLanguage.ID {
+			continue
+		}
+		if existData, _ := userProjectTranslationRepo.ReadByLanguageIDUserProjectID(language.ID, userProject.ID); existData != nil {
+			continue
+		}
+
+		name, err := translator.Translate(sourceTranslation.Name, sourceLanguage.Code, language.Code)
+		if err == nil {
+			var description string
+			description, err = translator.Translate(sourceTranslation.Description, sourceLanguage.Code, language.Code)
+			if err == nil {
+				_, err = userProjectTranslationRepo.Create(&models.UserProjectTranslation{
+					LanguageID:        uint(language.ID),
+					UserProjectID:     uint(userProject.ID),
+					Name:              helper.TruncateString(name, nameMaxLength),
+					Description:       helper.TruncateString(description, descriptionMaxLength),
+					MachineTranslated: true,
+				})
+			}
+		}
+
+		if err != nil {
+			failed = append(failed, map[string]interface{}{
+				"language_id": language.ID,
+				"code":        language.Code,
+				"message":     err.Error(),
+			})
+			continue
+		}
+		created = append(created, map[string]interface{}{
+			"language_id": language.ID,
+			"code":        language.Code,
+		})
+	}
+
+	response := helper.Response{
+		Message: "success",
+		Data: map[string]interface{}{
+			"created": created,
+			"failed":  failed,
+		},
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserProjectTranslationCreateForm true "User Project input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-project-translation [post]
 func CreateUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userProjectTranslationInput models.UserProjectTranslationCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userProjectTranslationInput); err != nil {
-		log.Fatal("Error decoding new user project: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user project translation input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userProjectTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	userProjectRepo := repositories.NewUserProjectRepository()
 	userProjectTranslationRepo := repositories.NewUserProjectTranslationRepository()
 
@@ -40,22 +51,19 @@ func CreateUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
 	userProject, user_project_err := userProjectRepo.Read(userProjectTranslationInput.UserProjectID)
 	if user_project_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Project not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Project not found")
 		return
 	}
 
 	if userProject.UserID != uint(userID) {
-		response := map[string]string{"message": "Not allowing create"}
-		helper.ResponseJSON(w, http.StatusForbidden, response)
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing create")
 		return
 	}
 
 	exist_data, _ := userProjectTranslationRepo.ReadByLanguageIDUserProjectID(userProjectTranslationInput.LanguageID, userProjectTranslationInput.UserProjectID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Project already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Project already added")
 		return
 	}
 
@@ -69,13 +77,12 @@ func CreateUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserProjectTranslation, err := userProjectTranslationRepo.Create(&newUserProjectTranslationData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user project translation"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user project translation")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserProjectTranslation.ID,
 			},
 		}
@@ -84,6 +91,90 @@ func CreateUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user project translation godoc
+// @Summary Update User project translation
+// @Description Update user project translation, only provided fields are changed
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User Project Translation ID"
+// @Param input body models.UserProjectTranslationEditForm true "User Project input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-project-translation/{id} [put]
+// @Router /user-project-translation/{id} [patch]
+func UpdateUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	userProjectTranslationIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project Translation not provided")
+		return
+	}
+
+	var userProjectTranslationInput models.UserProjectTranslationEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userProjectTranslationInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user project translation input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userProjectTranslationInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userProjectRepo := repositories.NewUserProjectRepository()
+	userProjectTranslationRepo := repositories.NewUserProjectTranslationRepository()
+	userProjectTranslationID := helper.ParseIDStringToInt(userProjectTranslationIDStr)
+
+	userProjectTranslation, err := userProjectTranslationRepo.Read(userProjectTranslationID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project Translation ID not found")
+		return
+	}
+
+	userProject, err := userProjectRepo.Read(int64(userProjectTranslation.UserProjectID))
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
+		return
+	}
+
+	if userProject.UserID != uint(userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
+		return
+	}
+
+	if userProjectTranslationInput.Name != nil {
+		userProjectTranslation.Name = *userProjectTranslationInput.Name
+	}
+	if userProjectTranslationInput.Description != nil {
+		userProjectTranslation.Description = *userProjectTranslationInput.Description
+	}
+	// edited by human, draft become approved translation
+	userProjectTranslation.MachineTranslated = false
+
+	if _, err := userProjectTranslationRepo.Update(userProjectTranslation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user project translation")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user project translation godoc
 // @Summary Delete User project translation
 // @Description Delete user project translation
@@ -91,20 +182,23 @@ func CreateUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param id path int true "User Project Translation ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Success 403 {object} map[string]string "Forbidden"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-project-translation/{id} [delete]
 func DeleteUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	userProjectTranslationIDStr, ok := vars["id"]
 	if !ok {
-		response := map[string]string{"message": "User Project Translation not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project Translation not provided")
 		return
 	}
 
@@ -114,33 +208,89 @@ func DeleteUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
 
 	userProjectTranslation, err := userProjectTranslationRepo.Read(userProjectTranslationID)
 	if err != nil {
-		response := map[string]string{"message": "User Project Translation ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Project Translation ID not found")
 		return
 	}
 
 	userProject, err := userProjectRepo.Read(int64(userProjectTranslation.UserProjectID))
 	if err != nil {
-		response := map[string]string{"message": "User Project ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
 		return
 	}
 
 	if userProject.UserID != uint(userID) {
-		response := map[string]string{"message": "Not allowing delete"}
-		helper.ResponseJSON(w, http.StatusForbidden, response)
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing delete")
 		return
 	}
 
 	errDelete := userProjectTranslationRepo.Delete(userProjectTranslationID)
 	if errDelete != nil {
-		response := map[string]string{"message": "User Project Translation ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, errDelete, "User Project Translation ID not found")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
+// approve user project translation godoc
+// @Summary Approve User project translation
+// @Description Approve machine translated draft without changing it
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param id path int true "User Project Translation ID"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Success 403 {object} helper.Response "Forbidden"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Router /user-project-translation/{id}/approve [post]
+func ApproveUserProjectTranslation(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	userProjectTranslationIDStr, ok := vars["id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User Project Translation not provided")
+		return
+	}
+
+	userProjectRepo := repositories.NewUserProjectRepository()
+	userProjectTranslationRepo := repositories.NewUserProjectTranslationRepository()
+	userProjectTranslationID := helper.ParseIDStringToInt(userProjectTranslationIDStr)
+
+	userProjectTranslation, err := userProjectTranslationRepo.Read(userProjectTranslationID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project Translation ID not found")
+		return
+	}
+
+	userProject, err := userProjectRepo.Read(int64(userProjectTranslation.UserProjectID))
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Project ID not found")
+		return
+	}
+
+	if userProject.UserID != uint(userID) {
+		helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Not allowing update")
+		return
+	}
+
+	userProjectTranslation.MachineTranslated = false
+	if _, err := userProjectTranslationRepo.Update(userProjectTranslation); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to approve user project translation")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
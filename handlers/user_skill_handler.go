@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"encoding/json"
-	"log"
 	"net/http"
 
 	"github.com/FRanggaY/personal-portfolio-api/helper"
@@ -18,21 +17,33 @@ import (
 // @Accept json
 // @Produce json
 // @Param input body models.UserSkillCreateForm true "User skill input"
-// @Success 201 {object} map[string]string "Created"
-// @Failure 400 {object} map[string]string "Bad Request"
+// @Success 201 {object} helper.Response "Created"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 409 {object} helper.Response "Conflict"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
 // @Router /user-skill [post]
 func CreateUserSkill(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	// define input from json
 	var userSkillInput models.UserSkillCreateForm
 	decoder := json.NewDecoder(r.Body)
 	if err := decoder.Decode(&userSkillInput); err != nil {
-		log.Fatal("Error decoding new user skill: ")
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user skill input")
+		return
 	}
 	defer r.Body.Close()
 
+	if fieldErrors := helper.ValidateStruct(userSkillInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
 	userRepo := repositories.NewUserRepository()
 	skillRepo := repositories.NewSkillRepository()
 	userSkillRepo := repositories.NewUserSkillRepository()
@@ -41,8 +52,7 @@ func CreateUserSkill(w http.ResponseWriter, r *http.Request) {
 	_, user_err := userRepo.Read(userID)
 	if user_err != nil {
 		// Handle error
-		response := map[string]string{"message": "User ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "User ID not found")
 		return
 	}
 
@@ -50,8 +60,7 @@ func CreateUserSkill(w http.ResponseWriter, r *http.Request) {
 	_, skill_err := skillRepo.Read(userSkillInput.SkillID)
 	if skill_err != nil {
 		// Handle error
-		response := map[string]string{"message": "Skill ID not found"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not found")
 		return
 	}
 
@@ -59,8 +68,7 @@ func CreateUserSkill(w http.ResponseWriter, r *http.Request) {
 	exist_data, _ := userSkillRepo.ReadByUserIDSkillID(userID, userSkillInput.SkillID)
 	if exist_data != nil {
 		// Handle error
-		response := map[string]string{"message": "Skill already added"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseError(w, http.StatusConflict, helper.ErrorCodeConflict, "Skill already added")
 		return
 	}
 
@@ -72,13 +80,12 @@ func CreateUserSkill(w http.ResponseWriter, r *http.Request) {
 	// insert to database
 	if newUserSkill, err := userSkillRepo.Create(&newUserSkillData); err != nil {
 		// Handle error
-		response := map[string]string{"message": "Error creating new user skill"}
-		helper.ResponseJSON(w, http.StatusBadRequest, response)
+		helper.ResponseDatabaseError(w, err, "Error creating new user skill")
 		return
 	} else {
-		response := map[string]interface{}{
-			"message": "success",
-			"data": map[string]interface{}{
+		response := helper.Response{
+			Message: "success",
+			Data: map[string]interface{}{
 				"id": newUserSkill.ID,
 			},
 		}
@@ -87,6 +94,70 @@ func CreateUserSkill(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// update user skill godoc
+// @Summary Update User Skill
+// @Description Update user skill
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param skill_id path int true "Skill ID"
+// @Param input body models.UserSkillEditForm true "User skill input"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 400 {object} helper.Response "Bad Request"
+// @Failure 404 {object} helper.Response "Not Found"
+// @Failure 422 {object} helper.Response "Unprocessable Entity"
+// @Router /user-skill/{skill_id} [patch]
+func UpdateUserSkill(w http.ResponseWriter, r *http.Request) {
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
+	userID := jwtClaim.Id
+
+	vars := mux.Vars(r)
+	skillIDStr, ok := vars["skill_id"]
+	if !ok {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not provided")
+		return
+	}
+
+	var userSkillInput models.UserSkillEditForm
+	if err := json.NewDecoder(r.Body).Decode(&userSkillInput); err != nil {
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeInvalidBody, "Failed to decode user skill input")
+		return
+	}
+	defer r.Body.Close()
+
+	if fieldErrors := helper.ValidateStruct(userSkillInput); len(fieldErrors) > 0 {
+		helper.ResponseValidationError(w, fieldErrors)
+		return
+	}
+
+	userSkillRepo := repositories.NewUserSkillRepository()
+	skillID := helper.ParseIDStringToInt(skillIDStr)
+	userSkill, err := userSkillRepo.ReadByUserIDSkillID(userID, skillID)
+	if err != nil {
+		helper.ResponseDatabaseError(w, err, "User Skill ID not found")
+		return
+	}
+
+	if userSkillInput.IsActive != nil {
+		userSkill.IsActive = *userSkillInput.IsActive
+	}
+
+	if _, err := userSkillRepo.Update(userSkill); err != nil {
+		helper.ResponseDatabaseError(w, err, "Failed to update user skill")
+		return
+	}
+
+	response := helper.Response{
+		Message: "success",
+	}
+	helper.ResponseJSON(w, http.StatusOK, response)
+}
+
 // delete user skill godoc
 // @Summary Delete User Skill
 // @Description Delete user skill
@@ -94,33 +165,35 @@ func CreateUserSkill(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Param skill_id path int true "Skill ID"
-// @Success 200 {object} map[string]string "Success"
-// @Success 500 {object} map[string]string "Internal Server Error"
-// @Failure 404 {object} map[string]string "Not Found"
+// @Success 200 {object} helper.Response "Success"
+// @Success 500 {object} helper.Response "Internal Server Error"
+// @Failure 404 {object} helper.Response "Not Found"
 // @Router /user-skill/{skill_id} [delete]
 func DeleteUserSkill(w http.ResponseWriter, r *http.Request) {
-	jwtClaim, _ := helper.GetJWTClaim(r)
+	jwtClaim, err := helper.GetJWTClaim(r)
+	if err != nil {
+		helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+		return
+	}
 	userID := jwtClaim.Id
 
 	vars := mux.Vars(r)
 	skillIDStr, ok := vars["skill_id"]
 	if !ok {
-		response := map[string]string{"message": "Skill ID not found"}
-		helper.ResponseJSON(w, http.StatusInternalServerError, response)
+		helper.ResponseError(w, http.StatusBadRequest, helper.ErrorCodeBadRequest, "Skill ID not provided")
 		return
 	}
 
 	userSkillRepo := repositories.NewUserSkillRepository()
 	skillID := helper.ParseIDStringToInt(skillIDStr)
-	err := userSkillRepo.DeleteByUserIDSkillID(userID, skillID)
+	err = userSkillRepo.DeleteByUserIDSkillID(userID, skillID)
 	if err != nil {
-		response := map[string]string{"message": "User Skill ID not found"}
-		helper.ResponseJSON(w, http.StatusNotFound, response)
+		helper.ResponseDatabaseError(w, err, "User Skill ID not found")
 		return
 	}
 
-	response := map[string]interface{}{
-		"message": "success",
+	response := helper.Response{
+		Message: "success",
 	}
 	helper.ResponseJSON(w, http.StatusOK, response)
 }
@@ -0,0 +1,48 @@
+package helper
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+const APIKeyPrefix = "ppk_"
+
+// new api key, returned to user once
+func GenerateAPIKey() (string, error) {
+	token, err := GenerateRandomToken(32)
+	if err != nil {
+		return "", err
+	}
+	return APIKeyPrefix + token, nil
+}
+
+// take api key from X-API-Key header or Authorization Bearer with api key prefix
+func GetAPIKeyString(r *http.Request) (string, bool) {
+	if apiKey := strings.TrimSpace(r.Header.Get("X-API-Key")); apiKey != "" {
+		return apiKey, true
+	}
+
+	if tokenString, err := GetTokenString(r); err == nil && strings.HasPrefix(tokenString, APIKeyPrefix) {
+		return tokenString, true
+	}
+	return "", false
+}
+
+// jwt access token has every scope, api key only has the granted scopes
+func HasScope(r *http.Request, scope string) bool {
+	jwtClaim, err := GetJWTClaim(r)
+	if err != nil {
+		return false
+	}
+	if jwtClaim.TokenType != config.TokenTypeAPIKey {
+		return jwtClaim.TokenType == config.TokenTypeAccess
+	}
+	for _, claimScope := range jwtClaim.Scopes {
+		if claimScope == scope {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package helper
+
+import "sync"
+
+// run tasks concurrently with at most limit task at the same time, return the first error
+func RunConcurrently(limit int, tasks ...func() error) error {
+	if limit <= 0 {
+		limit = 1
+	}
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	semaphore := make(chan struct{}, limit)
+
+	for _, task := range tasks {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(task func() error) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			if err := task(); err != nil {
+				once.Do(func() { firstErr = err })
+			}
+		}(task)
+	}
+
+	wg.Wait()
+	return firstErr
+}
@@ -0,0 +1,196 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+var ErrCursorInvalid = errors.New("cursor invalid")
+
+// position in a list, bound to endpoint path and sort so it can not be reused for another list
+type Cursor struct {
+	Path  string      `json:"p"`
+	Sort  string      `json:"s"`
+	Order string      `json:"o"`
+	Value interface{} `json:"v,omitempty"`
+	// time sort value, kept apart so it is not read back as string
+	Time *time.Time `json:"t,omitempty"`
+	ID   int64      `json:"i"`
+	// true for previous page, rows before the position
+	Backward bool `json:"b,omitempty"`
+}
+
+// offset page, or cursor page when request has cursor parameter (empty cursor is first page)
+type Pagination struct {
+	Size       int
+	Number     int
+	CursorMode bool
+	Cursor     *Cursor
+	Path       string
+	Sort       string
+	Order      string
+}
+
+// opaque cursor, base64 payload and hmac signature
+func EncodeCursor(cursor Cursor) (string, error) {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	return encodedPayload + "." + signCursor(encodedPayload), nil
+}
+
+func DecodeCursor(value string) (*Cursor, error) {
+	encodedPayload, signature, ok := strings.Cut(value, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(signCursor(encodedPayload))) {
+		return nil, ErrCursorInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrCursorInvalid
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(payload, &cursor); err != nil {
+		return nil, ErrCursorInvalid
+	}
+	return &cursor, nil
+}
+
+func signCursor(encodedPayload string) string {
+	mac := hmac.New(sha256.New, config.GetCursorSigningKey())
+	mac.Write([]byte(encodedPayload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parse size and offset, or cursor which must be issued by the same endpoint with the same sort
+func ParsePagination(r *http.Request, sort string, order string) (Pagination, error) {
+	query := r.URL.Query()
+	pagination := Pagination{
+		Size:       ParsePageSize(query.Get("size")),
+		Number:     ParsePageNumber(query.Get("offset")),
+		CursorMode: query.Has("cursor"),
+		Path:       r.URL.Path,
+		Sort:       sort,
+		Order:      order,
+	}
+
+	if value := query.Get("cursor"); value != "" {
+		cursor, err := DecodeCursor(value)
+		if err != nil {
+			return pagination, err
+		}
+		if cursor.Path != pagination.Path || cursor.Sort != sort || cursor.Order != order {
+			return pagination, errors.New("cursor does not match this list")
+		}
+		pagination.Cursor = cursor
+	}
+	return pagination, nil
+}
+
+// cursor page read one more row than page size, trim it and build next and previous cursor.
+// sort value and id are taken from the row field which json name is the sort and id
+func BuildCursorPage[T any](rows []T, pagination Pagination) ([]T, *string, *string, error) {
+	backward := pagination.Cursor != nil && pagination.Cursor.Backward
+	hasMore := len(rows) > pagination.Size
+	if hasMore {
+		rows = rows[:pagination.Size]
+	}
+	if backward {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+	if len(rows) == 0 {
+		return rows, nil, nil, nil
+	}
+
+	// forward page has next page when more row found, backward page always come from next page
+	var nextCursor, prevCursor *string
+	if backward || hasMore {
+		cursor, err := encodeRowCursor(rows[len(rows)-1], pagination, false)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		nextCursor = &cursor
+	}
+	if (backward && hasMore) || (!backward && pagination.Cursor != nil) {
+		cursor, err := encodeRowCursor(rows[0], pagination, true)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		prevCursor = &cursor
+	}
+	return rows, nextCursor, prevCursor, nil
+}
+
+// meta of list, offset page has total count, cursor page has cursors
+func PageMeta(pagination Pagination, totalCount int, nextCursor, prevCursor *string) map[string]interface{} {
+	if !pagination.CursorMode {
+		return map[string]interface{}{
+			"size":       pagination.Size,
+			"offset":     pagination.Number,
+			"totalCount": totalCount,
+			"totalPage":  int(math.Ceil(float64(totalCount) / float64(pagination.Size))),
+		}
+	}
+
+	var cursor *string
+	if pagination.Cursor != nil {
+		encoded, _ := EncodeCursor(*pagination.Cursor)
+		cursor = &encoded
+	}
+	return map[string]interface{}{
+		"size":        pagination.Size,
+		"cursor":      cursor,
+		"next_cursor": nextCursor,
+		"prev_cursor": prevCursor,
+	}
+}
+
+func encodeRowCursor(row interface{}, pagination Pagination, backward bool) (string, error) {
+	cursor := Cursor{
+		Path:     pagination.Path,
+		Sort:     pagination.Sort,
+		Order:    pagination.Order,
+		Backward: backward,
+	}
+
+	rowValue := reflect.Indirect(reflect.ValueOf(row))
+	for i := 0; i < rowValue.NumField(); i++ {
+		jsonName := strings.Split(rowValue.Type().Field(i).Tag.Get("json"), ",")[0]
+		if jsonName == "id" {
+			cursor.ID = rowValue.Field(i).Int()
+		}
+		if jsonName == pagination.Sort && pagination.Sort != "id" {
+			switch value := rowValue.Field(i).Interface().(type) {
+			case time.Time:
+				cursor.Time = &value
+			case *time.Time:
+				cursor.Time = value
+			default:
+				cursor.Value = value
+			}
+		}
+	}
+	return EncodeCursor(cursor)
+}
+
+// sort value of cursor to compare with the column
+func CursorValue(cursor *Cursor) interface{} {
+	if cursor.Time != nil {
+		return *cursor.Time
+	}
+	return cursor.Value
+}
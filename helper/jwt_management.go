@@ -1,18 +1,44 @@
 package helper
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"net/http"
+	"strings"
 
 	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func GetJWTClaim(r *http.Request) (config.JWTClaim, error) {
-	c, _ := r.Cookie("token")
+type contextKey string
+
+const jwtClaimContextKey contextKey = "jwt_claim"
+
+var ErrTokenNotFound = errors.New("token not found")
+
+// take token from Authorization Bearer header, fallback to token cookie
+func GetTokenString(r *http.Request) (string, error) {
+	authorization := r.Header.Get("Authorization")
+	if authorization != "" {
+		scheme, tokenString, found := strings.Cut(authorization, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(tokenString) == "" {
+			return "", ErrTokenNotFound
+		}
+		return strings.TrimSpace(tokenString), nil
+	}
 
-	tokenString := c.Value
+	c, err := r.Cookie("token")
+	if err != nil || c.Value == "" {
+		return "", ErrTokenNotFound
+	}
+
+	return c.Value, nil
+}
 
+func ParseJWTClaim(tokenString string) (config.JWTClaim, error) {
 	claims := &config.JWTClaim{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
 		return config.JWT_KEY, nil
@@ -27,3 +53,33 @@ func GetJWTClaim(r *http.Request) (config.JWTClaim, error) {
 
 	return *claims, nil
 }
+
+// store claims in request context, called once by JWTMiddleware
+func SetJWTClaim(r *http.Request, claims config.JWTClaim) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), jwtClaimContextKey, claims))
+}
+
+// read claims stored by JWTMiddleware
+func GetJWTClaim(r *http.Request) (config.JWTClaim, error) {
+	claims, ok := r.Context().Value(jwtClaimContextKey).(config.JWTClaim)
+	if !ok {
+		return config.JWTClaim{}, errors.New("jwt claim not found in request context")
+	}
+
+	return claims, nil
+}
+
+// random hex string, used for jti, token family and refresh token
+func GenerateRandomToken(byteLength int) (string, error) {
+	b := make([]byte, byteLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// sha256 hex of token, refresh token never saved as plain text
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,91 @@
+package helper
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// list parameter shared by list endpoint, sort is already checked against allowed columns
+type ListQuery struct {
+	Search      string
+	Sort        string
+	Order       string
+	CreatedFrom *time.Time
+	// exclusive upper bound
+	CreatedTo  *time.Time
+	Pagination Pagination
+}
+
+// parse q, sort, order, created_from, created_to and pagination.
+// date accept YYYY-MM-DD or RFC3339, created_to with date only include the whole day
+func ParseListQuery(r *http.Request, sortColumns []string) (ListQuery, error) {
+	query := r.URL.Query()
+	listQuery := ListQuery{
+		Search: strings.TrimSpace(query.Get("q")),
+		Sort:   query.Get("sort"),
+		Order:  strings.ToLower(query.Get("order")),
+	}
+
+	if listQuery.Sort == "" {
+		listQuery.Sort = "id"
+	}
+	if !slices.Contains(sortColumns, listQuery.Sort) {
+		return listQuery, errors.New("sort must be one of " + strings.Join(sortColumns, ", "))
+	}
+
+	if listQuery.Order == "" {
+		listQuery.Order = SortOrderAsc
+	}
+	if listQuery.Order != SortOrderAsc && listQuery.Order != SortOrderDesc {
+		return listQuery, errors.New("order must be asc or desc")
+	}
+
+	if value := query.Get("created_from"); value != "" {
+		createdFrom, _, err := parseListDate(value)
+		if err != nil {
+			return listQuery, errors.New("created_from must be YYYY-MM-DD or RFC3339")
+		}
+		listQuery.CreatedFrom = &createdFrom
+	}
+
+	if value := query.Get("created_to"); value != "" {
+		createdTo, dateOnly, err := parseListDate(value)
+		if err != nil {
+			return listQuery, errors.New("created_to must be YYYY-MM-DD or RFC3339")
+		}
+		if dateOnly {
+			createdTo = createdTo.AddDate(0, 0, 1)
+		} else {
+			createdTo = createdTo.Add(time.Second)
+		}
+		listQuery.CreatedTo = &createdTo
+	}
+
+	if listQuery.CreatedFrom != nil && listQuery.CreatedTo != nil && !listQuery.CreatedFrom.Before(*listQuery.CreatedTo) {
+		return listQuery, errors.New("created_from must be before created_to")
+	}
+
+	pagination, err := ParsePagination(r, listQuery.Sort, listQuery.Order)
+	if err != nil {
+		return listQuery, err
+	}
+	listQuery.Pagination = pagination
+
+	return listQuery, nil
+}
+
+func parseListDate(value string) (time.Time, bool, error) {
+	if date, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return date, true, nil
+	}
+	date, err := time.Parse(time.RFC3339, value)
+	return date, false, err
+}
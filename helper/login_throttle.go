@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// delay before next login attempt allowed, exponential after free attempts and lockout when max attempts reached
+func LoginBackoff(failedCount, freeAttempts, maxAttempts int, baseDelay, lockoutDuration time.Duration) (time.Duration, bool) {
+	if failedCount >= maxAttempts {
+		return lockoutDuration, true
+	}
+	if failedCount <= freeAttempts {
+		return 0, false
+	}
+
+	delay := baseDelay
+	for i := freeAttempts + 1; i < failedCount; i++ {
+		delay *= 2
+		if delay >= lockoutDuration {
+			return lockoutDuration, false
+		}
+	}
+	return delay, false
+}
+
+// client ip from remote address, X-Forwarded-For only used behind trusted proxy
+func GetClientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if forwardedFor := r.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+			clientIP, _, _ := strings.Cut(forwardedFor, ",")
+			if clientIP = strings.TrimSpace(clientIP); clientIP != "" {
+				return clientIP
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
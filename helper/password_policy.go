@@ -0,0 +1,76 @@
+package helper
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+//go:embed breached_passwords.txt
+var bundledBreachedPasswords []byte
+
+var (
+	breachedPasswords     = map[string]map[string]struct{}{}
+	breachedPasswordsLock sync.Mutex
+)
+
+// validate password against policy, return error message or empty string when valid
+func ValidatePassword(password string, policy config.PasswordPolicyConfig) (string, error) {
+	// counted in bytes, bcrypt only use the first 72 bytes
+	if len(password) < policy.MinLength || len(password) > policy.MaxLength {
+		return fmt.Sprintf("password must be between %d and %d bytes", policy.MinLength, policy.MaxLength), nil
+	}
+
+	breached, err := loadBreachedPasswords(policy.BreachedListPath)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := breached[strings.ToLower(password)]; ok {
+		return "password is too common, please choose another password", nil
+	}
+
+	return "", nil
+}
+
+// load breached list once per path, empty path use bundled list
+func loadBreachedPasswords(path string) (map[string]struct{}, error) {
+	breachedPasswordsLock.Lock()
+	defer breachedPasswordsLock.Unlock()
+
+	if list, ok := breachedPasswords[path]; ok {
+		return list, nil
+	}
+
+	var reader io.Reader = bytes.NewReader(bundledBreachedPasswords)
+	if path != "" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	list := map[string]struct{}{}
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		list[strings.ToLower(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	breachedPasswords[path] = list
+	return list, nil
+}
@@ -2,12 +2,108 @@ package helper
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
+
+	"gorm.io/gorm"
+)
+
+// error code is stable for client to check, message is only for human
+const (
+	ErrorCodeBadRequest      = "bad_request"
+	ErrorCodeInvalidBody     = "invalid_body"
+	ErrorCodeValidation      = "validation_failed"
+	ErrorCodeUnauthorized    = "unauthorized"
+	ErrorCodeForbidden       = "forbidden"
+	ErrorCodeNotFound        = "not_found"
+	ErrorCodeConflict        = "conflict"
+	ErrorCodePayloadTooLarge = "payload_too_large"
+	ErrorCodeTooManyRequests = "too_many_requests"
+	ErrorCodeInternal        = "internal_error"
 )
 
-func ResponseJSON(w http.ResponseWriter, code int, payload interface{}) {
+// envelope of every json response, data and meta on success, error on failure
+type Response struct {
+	Message string      `json:"message,omitempty"`
+	Data    interface{} `json:"data,omitempty"`
+	Meta    interface{} `json:"meta,omitempty"`
+	Error   *ErrorBody  `json:"error,omitempty"`
+}
+
+type ErrorBody struct {
+	Code        string       `json:"code"`
+	Message     string       `json:"message"`
+	Details     interface{}  `json:"details,omitempty"`
+	FieldErrors []FieldError `json:"field_errors,omitempty"`
+}
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// write response envelope, failure status always has error body
+func ResponseJSON(w http.ResponseWriter, code int, payload Response) {
+	if code >= http.StatusBadRequest && payload.Error == nil {
+		payload.Error = &ErrorBody{Code: ErrorCodeFromStatus(code), Message: payload.Message}
+		payload.Message = ""
+	}
+
 	response, _ := json.Marshal(payload)
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(code)
 	w.Write(response)
 }
+
+func ResponseError(w http.ResponseWriter, status int, code string, message string) {
+	ResponseJSON(w, status, Response{Error: &ErrorBody{Code: code, Message: message}})
+}
+
+// every invalid field at once
+func ResponseValidationError(w http.ResponseWriter, fieldErrors []FieldError) {
+	response := Response{
+		Error: &ErrorBody{
+			Code:        ErrorCodeValidation,
+			Message:     "Validation failed",
+			FieldErrors: fieldErrors,
+		},
+	}
+	ResponseJSON(w, http.StatusUnprocessableEntity, response)
+}
+
+// database error of repository, record not found is 404, duplicate key is 409, other is 500
+func ResponseDatabaseError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		ResponseError(w, http.StatusNotFound, ErrorCodeNotFound, message)
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		ResponseError(w, http.StatusConflict, ErrorCodeConflict, message)
+	default:
+		ResponseError(w, http.StatusInternalServerError, ErrorCodeInternal, message)
+	}
+}
+
+// default error code of failure status
+func ErrorCodeFromStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return ErrorCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusConflict:
+		return ErrorCodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return ErrorCodePayloadTooLarge
+	case http.StatusUnprocessableEntity:
+		return ErrorCodeValidation
+	case http.StatusTooManyRequests:
+		return ErrorCodeTooManyRequests
+	default:
+		return ErrorCodeInternal
+	}
+}
@@ -0,0 +1,126 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// RFC 6238 time based one time password with HMAC-SHA1
+type TOTP struct {
+	Secret []byte
+	Digits int
+	Period time.Duration
+	// accepted time step before and after current time step
+	Skew int
+	// clock of the validator, replaced with fixed clock on test
+	Now func() time.Time
+}
+
+func NewTOTP(secret []byte) *TOTP {
+	return &TOTP{
+		Secret: secret,
+		Digits: 6,
+		Period: 30 * time.Second,
+		Skew:   1,
+		Now:    time.Now,
+	}
+}
+
+func GenerateTOTPSecret() ([]byte, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+func EncodeTOTPSecret(secret []byte) string {
+	return totpEncoding.EncodeToString(secret)
+}
+
+func DecodeTOTPSecret(secret string) ([]byte, error) {
+	return totpEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+}
+
+// otpauth uri to be rendered as qr code by authenticator app
+func TOTPProvisioningURI(issuer, accountName string, secret []byte) string {
+	label := url.PathEscape(issuer + ":" + accountName)
+	query := url.Values{}
+	query.Set("secret", EncodeTOTPSecret(secret))
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", "6")
+	query.Set("period", "30")
+	// some authenticator app show "+" as is, use %20 for space
+	return "otpauth://totp/" + label + "?" + strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+func (totp *TOTP) Counter(at time.Time) int64 {
+	return at.Unix() / int64(totp.Period/time.Second)
+}
+
+// RFC 4226 HOTP value of counter
+func (totp *TOTP) CodeAtCounter(counter int64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(counter))
+
+	mac := hmac.New(sha1.New, totp.Secret)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	// dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totp.Digits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", totp.Digits, value%modulo)
+}
+
+func (totp *TOTP) CodeAt(at time.Time) string {
+	return totp.CodeAtCounter(totp.Counter(at))
+}
+
+// validate code around current time, return matched counter so caller can reject reused code
+func (totp *TOTP) Validate(code string) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totp.Digits {
+		return 0, false
+	}
+
+	current := totp.Counter(totp.Now())
+	for i := -totp.Skew; i <= totp.Skew; i++ {
+		counter := current + int64(i)
+		if subtle.ConstantTimeCompare([]byte(totp.CodeAtCounter(counter)), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// random recovery code formatted as xxxxx-xxxxx
+func GenerateRecoveryCode() (string, error) {
+	b := make([]byte, 7)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	code := strings.ToLower(totpEncoding.EncodeToString(b))[:10]
+	return code[:5] + "-" + code[5:], nil
+}
+
+// recovery code compared without dash and case
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
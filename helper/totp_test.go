@@ -0,0 +1,97 @@
+package helper
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 appendix B, sha1 with 8 digits
+func TestTOTPCodeAtRFC6238(t *testing.T) {
+	totp := NewTOTP([]byte("12345678901234567890"))
+	totp.Digits = 8
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+	for _, test := range tests {
+		if got := totp.CodeAt(time.Unix(test.unix, 0)); got != test.want {
+			t.Errorf("CodeAt(%d) = %s, want %s", test.unix, got, test.want)
+		}
+	}
+}
+
+func TestTOTPValidateWindow(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	totp := NewTOTP([]byte("12345678901234567890"))
+	totp.Now = func() time.Time { return now }
+	current := totp.Counter(now)
+
+	tests := []struct {
+		offset int64
+		ok     bool
+	}{
+		{-2, false},
+		{-1, true},
+		{0, true},
+		{1, true},
+		{2, false},
+	}
+	for _, test := range tests {
+		code := totp.CodeAtCounter(current + test.offset)
+		counter, ok := totp.Validate(code)
+		if ok != test.ok {
+			t.Errorf("Validate(step %+d) ok = %v, want %v", test.offset, ok, test.ok)
+		}
+		if ok && counter != current+test.offset {
+			t.Errorf("Validate(step %+d) counter = %d, want %d", test.offset, counter, current+test.offset)
+		}
+	}
+
+	if _, ok := totp.Validate("12345"); ok {
+		t.Error("Validate accepted code with wrong length")
+	}
+}
+
+// caller only accept counter greater than the last used one, see UserMFARepository.UseCounter
+func TestTOTPValidateReplay(t *testing.T) {
+	now := time.Unix(1234567890, 0)
+	totp := NewTOTP([]byte("12345678901234567890"))
+	totp.Now = func() time.Time { return now }
+
+	lastUsedCounter := int64(0)
+	use := func(code string) bool {
+		counter, ok := totp.Validate(code)
+		if !ok || counter <= lastUsedCounter {
+			return false
+		}
+		lastUsedCounter = counter
+		return true
+	}
+
+	code := totp.CodeAt(now)
+	if !use(code) {
+		t.Fatal("first use of code is rejected")
+	}
+	if use(code) {
+		t.Error("same code is accepted twice")
+	}
+	if use(totp.CodeAtCounter(totp.Counter(now) - 1)) {
+		t.Error("code of earlier step is accepted after later step was used")
+	}
+
+	now = now.Add(totp.Period)
+	if use(code) {
+		t.Error("used code is accepted again in the next step")
+	}
+	if !use(totp.CodeAt(now)) {
+		t.Error("code of next step is rejected")
+	}
+}
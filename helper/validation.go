@@ -0,0 +1,193 @@
+package helper
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// field error code
+const (
+	FieldErrorRequired      = "required"
+	FieldErrorTooShort      = "too_short"
+	FieldErrorTooLong       = "too_long"
+	FieldErrorTooSmall      = "too_small"
+	FieldErrorTooLarge      = "too_large"
+	FieldErrorInvalidChoice = "invalid_choice"
+	FieldErrorInvalidRange  = "invalid_range"
+	FieldErrorInvalidDate   = "invalid_date"
+	FieldErrorInvalidType   = "invalid_type"
+)
+
+// form with rule across fields, checked after the tag rules
+type FormValidator interface {
+	ValidateForm() []FieldError
+}
+
+// validate struct from validate tag, every invalid field is returned.
+// rules: required, omitempty, min=n, max=n, oneof=a b, gtefield=Field.
+// max length of string also come from gorm size tag, nil pointer field is not provided and skipped
+func ValidateStruct(form interface{}) []FieldError {
+	formValue := reflect.Indirect(reflect.ValueOf(form))
+	formType := formValue.Type()
+
+	var fieldErrors []FieldError
+	for i := 0; i < formType.NumField(); i++ {
+		field := formType.Field(i)
+		rules := field.Tag.Get("validate")
+		maxLength := gormSize(field.Tag.Get("gorm"))
+		if rules == "" && maxLength == 0 {
+			continue
+		}
+
+		value := formValue.Field(i)
+		if value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				continue
+			}
+			value = value.Elem()
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = field.Name
+		}
+		if fieldError := validateField(formValue, name, value, rules, maxLength); fieldError != nil {
+			fieldErrors = append(fieldErrors, *fieldError)
+		}
+	}
+
+	// one error per field, field rule come first
+	if formValidator, ok := form.(FormValidator); ok {
+		for _, formError := range formValidator.ValidateForm() {
+			if !slices.ContainsFunc(fieldErrors, func(fieldError FieldError) bool { return fieldError.Field == formError.Field }) {
+				fieldErrors = append(fieldErrors, formError)
+			}
+		}
+	}
+	return fieldErrors
+}
+
+// first broken rule of the field
+func validateField(formValue reflect.Value, name string, value reflect.Value, rules string, maxLength int) *FieldError {
+	isString := value.Kind() == reflect.String
+	isZero := value.IsZero() || (isString && strings.TrimSpace(value.String()) == "")
+
+	for _, rule := range strings.Split(rules, ",") {
+		rule, param, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		switch rule {
+		case "required":
+			if isZero {
+				return &FieldError{Field: name, Code: FieldErrorRequired, Message: name + " is required"}
+			}
+		case "omitempty":
+			if isZero {
+				return nil
+			}
+		case "min":
+			limit, _ := strconv.ParseInt(param, 10, 64)
+			if isString && int64(utf8.RuneCountInString(value.String())) < limit {
+				return &FieldError{Field: name, Code: FieldErrorTooShort, Message: fmt.Sprintf("%s must be at least %d characters", name, limit)}
+			}
+			if number, ok := numberValue(value); ok && number < limit {
+				return &FieldError{Field: name, Code: FieldErrorTooSmall, Message: fmt.Sprintf("%s must be at least %d", name, limit)}
+			}
+		case "max":
+			limit, _ := strconv.ParseInt(param, 10, 64)
+			if isString && int64(utf8.RuneCountInString(value.String())) > limit {
+				return &FieldError{Field: name, Code: FieldErrorTooLong, Message: fmt.Sprintf("%s must be at most %d characters", name, limit)}
+			}
+			if number, ok := numberValue(value); ok && number > limit {
+				return &FieldError{Field: name, Code: FieldErrorTooLarge, Message: fmt.Sprintf("%s must be at most %d", name, limit)}
+			}
+		case "oneof":
+			choices := strings.Fields(param)
+			if isString && !slices.Contains(choices, value.String()) {
+				return &FieldError{Field: name, Code: FieldErrorInvalidChoice, Message: fmt.Sprintf("%s must be one of %s", name, strings.Join(choices, ", "))}
+			}
+		case "gtefield":
+			other := reflect.Indirect(formValue.FieldByName(param))
+			number, ok := numberValue(value)
+			otherNumber, otherOk := numberValue(other)
+			if ok && otherOk && otherNumber != 0 && number < otherNumber {
+				return &FieldError{Field: name, Code: FieldErrorInvalidRange, Message: fmt.Sprintf("%s must not be less than %s", name, jsonName(formValue.Type(), param))}
+			}
+		}
+	}
+
+	if isString && maxLength > 0 && utf8.RuneCountInString(value.String()) > maxLength {
+		return &FieldError{Field: name, Code: FieldErrorTooLong, Message: fmt.Sprintf("%s must be at most %d characters", name, maxLength)}
+	}
+	return nil
+}
+
+func numberValue(value reflect.Value) (int64, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(value.Uint()), true
+	}
+	return 0, false
+}
+
+func jsonName(structType reflect.Type, fieldName string) string {
+	if field, ok := structType.FieldByName(fieldName); ok {
+		if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+			return name
+		}
+	}
+	return fieldName
+}
+
+// period from month and year start until month and year end, zero end year mean still ongoing
+func ValidatePeriod(monthStart int, yearStart int64, monthEnd int, yearEnd int64) []FieldError {
+	if yearEnd == 0 {
+		return nil
+	}
+	if yearEnd < yearStart {
+		return []FieldError{{Field: "year_end", Code: FieldErrorInvalidRange, Message: "year_end must not be less than year_start"}}
+	}
+	if yearEnd == yearStart && monthEnd != 0 && monthEnd < monthStart {
+		return []FieldError{{Field: "month_end", Code: FieldErrorInvalidRange, Message: "month_end must not be before month_start in the same year"}}
+	}
+	return nil
+}
+
+// max length from gorm size tag of the field with the given json name, 0 when there is no size
+func GetSizeLimit(model interface{}, jsonName string) int {
+	modelType := reflect.TypeOf(model)
+	if modelType.Kind() == reflect.Ptr {
+		modelType = modelType.Elem()
+	}
+
+	for i := 0; i < modelType.NumField(); i++ {
+		field := modelType.Field(i)
+		if strings.Split(field.Tag.Get("json"), ",")[0] != jsonName {
+			continue
+		}
+		return gormSize(field.Tag.Get("gorm"))
+	}
+	return 0
+}
+
+func gormSize(tag string) int {
+	for _, setting := range strings.Split(tag, ";") {
+		if size, ok := strings.CutPrefix(strings.TrimSpace(setting), "size:"); ok {
+			limit, _ := strconv.Atoi(size)
+			return limit
+		}
+	}
+	return 0
+}
+
+// cut text to max length characters, max length 0 mean no limit
+func TruncateString(text string, maxLength int) string {
+	if maxLength <= 0 || utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+	return string([]rune(text)[:maxLength])
+}
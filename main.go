@@ -5,11 +5,13 @@ import (
 	"net/http"
 	"os"
 
+	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/docs"
 	"github.com/FRanggaY/personal-portfolio-api/handlers"
 	"github.com/FRanggaY/personal-portfolio-api/handlers/public_handlers"
 	"github.com/FRanggaY/personal-portfolio-api/middlewares"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
 	"github.com/gorilla/mux"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
@@ -19,6 +21,11 @@ func main() {
 
 	models.ConnectDatabase()
 
+	// make sure at least one admin exist for managing master data
+	if err := repositories.NewUserRepository().SeedAdmin(config.GetAdminConfig()); err != nil {
+		log.Fatal("Failed to seed admin: ", err)
+	}
+
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080" // Default port if not provided in .env
@@ -33,24 +40,46 @@ func main() {
 	docs.SwaggerInfo.Schemes = []string{"http", "https"}
 	r.PathPrefix("/documentation/").Handler(httpSwagger.WrapHandler)
 
-	// static
-	r.PathPrefix("/assets/").Handler(http.StripPrefix("/assets/", http.FileServer(http.Dir("./assets/"))))
+	// static, only local storage is served by the api
+	storageConfig := config.GetStorageConfig()
+	if storageConfig.Driver != "s3" {
+		r.PathPrefix("/assets/").Handler(http.StripPrefix("/assets/", http.FileServer(http.Dir(storageConfig.LocalDirectory))))
+	}
 
 	api := r.PathPrefix(basePathRoute).Subrouter()
 	api.HandleFunc("/login", handlers.Login).Methods("POST")
+	api.HandleFunc("/login/mfa", handlers.LoginMFA).Methods("POST")
 	api.HandleFunc("/register", handlers.Register).Methods("POST")
-	api.HandleFunc("/logout", handlers.Logout).Methods("GET")
-
-	api.HandleFunc("/public/user/{username}", public_handlers.GetPublicFilteredPaginatedUserDetail).Methods("GET")
-	api.HandleFunc("/public/user/{username}/skill", public_handlers.GetPublicFilteredPaginatedUserSkillDetail).Methods("GET")
-	api.HandleFunc("/public/user/{username}/experience", public_handlers.GetPublicFilteredPaginatedUserExperienceDetail).Methods("GET")
-	api.HandleFunc("/public/user/{username}/education", public_handlers.GetPublicFilteredPaginatedUserEducationDetail).Methods("GET")
-	api.HandleFunc("/public/user/{username}/project", public_handlers.GetPublicFilteredPaginatedUserProjectDetail).Methods("GET")
-	api.HandleFunc("/public/user/{username}/project/{slug}", public_handlers.GetPublicProjectDetail).Methods("GET")
+	api.HandleFunc("/refresh", handlers.Refresh).Methods("POST")
+	api.HandleFunc("/password/forgot", handlers.ForgotPassword).Methods("POST")
+	api.HandleFunc("/password/reset", handlers.ResetPassword).Methods("POST")
+	api.HandleFunc("/logout", handlers.Logout).Methods("GET", "POST")
+
+	// public portfolio, api key with read:drafts scope include inactive rows
+	apiPublic := r.PathPrefix(basePathRoute).Subrouter()
+	apiPublic.HandleFunc("/public/user/{username}", public_handlers.GetPublicFilteredPaginatedUserDetail).Methods("GET")
+	apiPublic.HandleFunc("/public/user/{username}/portfolio", public_handlers.GetPublicUserPortfolio).Methods("GET")
+	apiPublic.HandleFunc("/public/user/{username}/skill", public_handlers.GetPublicFilteredPaginatedUserSkillDetail).Methods("GET")
+	apiPublic.HandleFunc("/public/user/{username}/experience", public_handlers.GetPublicFilteredPaginatedUserExperienceDetail).Methods("GET")
+	apiPublic.HandleFunc("/public/user/{username}/education", public_handlers.GetPublicFilteredPaginatedUserEducationDetail).Methods("GET")
+	apiPublic.HandleFunc("/public/user/{username}/project", public_handlers.GetPublicFilteredPaginatedUserProjectDetail).Methods("GET")
+	apiPublic.HandleFunc("/public/user/{username}/project/{slug}", public_handlers.GetPublicProjectDetail).Methods("GET")
+	apiPublic.HandleFunc("/public/user/{username}/search", public_handlers.SearchPublicUserPortfolio).Methods("GET")
+	apiPublic.Use(middlewares.OptionalAPIKeyMiddleware)
 
 	apiProtect := r.PathPrefix(basePathRoute).Subrouter()
 	apiProtect.HandleFunc("/profile", handlers.Profile).Methods("GET")
-	apiProtect.HandleFunc("/user", handlers.GetFilteredPaginatedUsers).Methods("GET")
+	apiProtect.HandleFunc("/profile/password", handlers.ChangePassword).Methods("PUT")
+	apiProtect.HandleFunc("/profile/translation-status", handlers.GetTranslationStatus).Methods("GET")
+	apiProtect.HandleFunc("/profile/translation/export", handlers.ExportTranslation).Methods("GET")
+	apiProtect.HandleFunc("/profile/translation/import", handlers.ImportTranslation).Methods("POST")
+	apiProtect.HandleFunc("/profile/api-key", handlers.GetUserAPIKeys).Methods("GET")
+	apiProtect.HandleFunc("/profile/api-key", handlers.CreateUserAPIKey).Methods("POST")
+	apiProtect.HandleFunc("/profile/api-key/{id}", handlers.RevokeUserAPIKey).Methods("DELETE")
+	apiProtect.HandleFunc("/profile/mfa", handlers.DisableMFA).Methods("DELETE")
+	apiProtect.HandleFunc("/profile/mfa/enroll", handlers.EnrollMFA).Methods("POST")
+	apiProtect.HandleFunc("/profile/mfa/verify", handlers.VerifyMFA).Methods("POST")
+	apiProtect.HandleFunc("/profile/mfa/recovery-codes", handlers.RegenerateRecoveryCodes).Methods("POST")
 
 	var userDetailRoute = "/user/{id}"
 	apiProtect.HandleFunc(userDetailRoute, handlers.GetUser).Methods("GET")
@@ -58,67 +87,115 @@ func main() {
 	apiProtect.HandleFunc(userDetailRoute, handlers.DeleteUser).Methods("DELETE")
 
 	apiProtect.HandleFunc("/user-skill", handlers.CreateUserSkill).Methods("POST")
+	apiProtect.HandleFunc("/user-skill/{skill_id}", handlers.UpdateUserSkill).Methods("PATCH")
 	apiProtect.HandleFunc("/user-skill/{skill_id}", handlers.DeleteUserSkill).Methods("DELETE")
 
 	apiProtect.HandleFunc("/user-language", handlers.CreateUserLanguage).Methods("POST")
+	apiProtect.HandleFunc("/user-language/{language_id}", handlers.UpdateUserLanguage).Methods("PATCH")
 	apiProtect.HandleFunc("/user-language/{language_id}", handlers.DeleteUserLanguage).Methods("DELETE")
 
 	apiProtect.HandleFunc("/user-language-translation", handlers.CreateUserLanguageTranslation).Methods("POST")
+	apiProtect.HandleFunc("/user-language-translation/{select_language_id}/{language_id}", handlers.UpdateUserLanguageTranslation).Methods("PUT", "PATCH")
 	apiProtect.HandleFunc("/user-language-translation/{select_language_id}/{language_id}", handlers.DeleteUserLanguageTranslation).Methods("DELETE")
 
 	apiProtect.HandleFunc("/user-experience", handlers.CreateUserExperience).Methods("POST")
+	apiProtect.HandleFunc("/user-experience/{company_id}", handlers.UpdateUserExperience).Methods("PATCH")
 	apiProtect.HandleFunc("/user-experience/{company_id}", handlers.DeleteUserExperience).Methods("DELETE")
 
 	apiProtect.HandleFunc("/user-position", handlers.CreateUserPosition).Methods("POST")
+	apiProtect.HandleFunc("/user-position/{id}", handlers.UpdateUserPosition).Methods("PATCH")
 	apiProtect.HandleFunc("/user-position/{id}", handlers.DeleteUserPosition).Methods("DELETE")
 
 	apiProtect.HandleFunc("/user-education", handlers.CreateUserEducation).Methods("POST")
+	apiProtect.HandleFunc("/user-education/{school_id}", handlers.UpdateUserEducation).Methods("PATCH")
 	apiProtect.HandleFunc("/user-education/{school_id}", handlers.DeleteUserEducation).Methods("DELETE")
 
-	apiProtect.HandleFunc("/user-project", handlers.CreateUserProject).Methods("POST")
-	apiProtect.HandleFunc("/user-project/{id}", handlers.DeleteUserProject).Methods("DELETE")
-
 	apiProtect.HandleFunc("/user-experience-translation", handlers.CreateUserExperienceTranslation).Methods("POST")
+	apiProtect.HandleFunc("/user-experience-translation/{company_id}/{language_id}", handlers.UpdateUserExperienceTranslation).Methods("PUT", "PATCH")
 	apiProtect.HandleFunc("/user-experience-translation/{company_id}/{language_id}", handlers.DeleteUserExperienceTranslation).Methods("DELETE")
 
 	apiProtect.HandleFunc("/user-education-translation", handlers.CreateUserEducationTranslation).Methods("POST")
+	apiProtect.HandleFunc("/user-education-translation/{school_id}/{language_id}", handlers.UpdateUserEducationTranslation).Methods("PUT", "PATCH")
 	apiProtect.HandleFunc("/user-education-translation/{school_id}/{language_id}", handlers.DeleteUserEducationTranslation).Methods("DELETE")
 
-	apiProtect.HandleFunc("/user-project-translation", handlers.CreateUserProjectTranslation).Methods("POST")
-	apiProtect.HandleFunc("/user-project-translation/{id}", handlers.DeleteUserProjectTranslation).Methods("DELETE")
-
-	apiProtect.HandleFunc("/user-project-attachment", handlers.CreateUserProjectAttachment).Methods("POST")
-	apiProtect.HandleFunc("/user-project-attachment/{id}", handlers.DeleteUserProjectAttachment).Methods("DELETE")
-
 	apiProtect.HandleFunc("/user-attachment", handlers.CreateUserAttachment).Methods("POST")
+	apiProtect.HandleFunc("/user-attachment/{id}", handlers.UpdateUserAttachment).Methods("PATCH")
 	apiProtect.HandleFunc("/user-attachment/{id}", handlers.DeleteUserAttachment).Methods("DELETE")
 
 	apiProtect.HandleFunc("/school", handlers.GetFilteredPaginatedSchools).Methods("GET")
-	apiProtect.HandleFunc("/school", handlers.CreateSchool).Methods("POST")
 	apiProtect.HandleFunc("/school/{id}", handlers.ReadSchool).Methods("GET")
 
 	apiProtect.HandleFunc("/company", handlers.GetFilteredPaginatedCompanies).Methods("GET")
-	apiProtect.HandleFunc("/company", handlers.CreateCompany).Methods("POST")
 	apiProtect.HandleFunc("/company/{id}", handlers.ReadCompany).Methods("GET")
 
 	apiProtect.HandleFunc("/language", handlers.GetFilteredPaginatedLanguages).Methods("GET")
-	apiProtect.HandleFunc("/language", handlers.CreateLanguage).Methods("POST")
 	apiProtect.HandleFunc("/language/{id}", handlers.ReadLanguage).Methods("GET")
 
 	apiProtect.HandleFunc("/skill", handlers.GetFilteredPaginatedSkills).Methods("GET")
-	apiProtect.HandleFunc("/skill", handlers.CreateSkill).Methods("POST")
 	apiProtect.HandleFunc("/skill/{id}", handlers.ReadSkill).Methods("GET")
 
-	apiProtect.HandleFunc("/skill-translation", handlers.CreateSkillTranslation).Methods("POST")
-	apiProtect.HandleFunc("/skill-translation/{skill_id}/{language_id}", handlers.DeleteSkillTranslation).Methods("DELETE")
-
 	apiProtect.HandleFunc("/project-platform", handlers.GetFilteredPaginatedProjectPlatforms).Methods("GET")
-	apiProtect.HandleFunc("/project-platform", handlers.CreateProjectPlatform).Methods("POST")
 	apiProtect.HandleFunc("/project-platform/{id}", handlers.ReadProjectPlatform).Methods("GET")
 
-	apiProtect.HandleFunc("/project-platform-translation", handlers.CreateProjectPlatformTranslation).Methods("POST")
-	apiProtect.HandleFunc("/project-platform-translation/{project_platform_id}/{language_id}", handlers.DeleteProjectPlatformTranslation).Methods("DELETE")
-	apiProtect.Use(middlewares.JWTMiddleware)
+	apiProtect.Use(middlewares.JWTMiddleware, middlewares.ScopeMiddleware())
+
+	// project resources, api key with write:projects scope allowed
+	apiProject := r.PathPrefix(basePathRoute).Subrouter()
+	apiProject.HandleFunc("/user-project", handlers.CreateUserProject).Methods("POST")
+	apiProject.HandleFunc("/user-project/{id}", handlers.UpdateUserProject).Methods("PATCH")
+	apiProject.HandleFunc("/user-project/{id}", handlers.DeleteUserProject).Methods("DELETE")
+	apiProject.HandleFunc("/user-project/{id}/machine-translate", handlers.MachineTranslateUserProject).Methods("POST")
+
+	apiProject.HandleFunc("/user-project-translation", handlers.CreateUserProjectTranslation).Methods("POST")
+	apiProject.HandleFunc("/user-project-translation/{id}", handlers.UpdateUserProjectTranslation).Methods("PUT", "PATCH")
+	apiProject.HandleFunc("/user-project-translation/{id}", handlers.DeleteUserProjectTranslation).Methods("DELETE")
+	apiProject.HandleFunc("/user-project-translation/{id}/approve", handlers.ApproveUserProjectTranslation).Methods("POST")
+
+	apiProject.HandleFunc("/user-project-attachment", handlers.CreateUserProjectAttachment).Methods("POST")
+	apiProject.HandleFunc("/user-project-attachment/{id}", handlers.UpdateUserProjectAttachment).Methods("PATCH")
+	apiProject.HandleFunc("/user-project-attachment/{id}", handlers.DeleteUserProjectAttachment).Methods("DELETE")
+	apiProject.Use(middlewares.JWTMiddleware, middlewares.ScopeMiddleware(models.APIKeyScopeWriteProjects))
+
+	// master data and user management only for admin
+	apiAdmin := r.PathPrefix(basePathRoute).Subrouter()
+	apiAdmin.HandleFunc("/user", handlers.GetFilteredPaginatedUsers).Methods("GET")
+	apiAdmin.HandleFunc("/user/{id}/role", handlers.UpdateUserRole).Methods("PATCH")
+	apiAdmin.HandleFunc("/user/{id}/session", handlers.DeleteUserSessions).Methods("DELETE")
+
+	apiAdmin.HandleFunc("/login-lock", handlers.GetLockedLoginAttempts).Methods("GET")
+	apiAdmin.HandleFunc("/login-lock/{id}", handlers.UnlockLoginAttempt).Methods("DELETE")
+	apiAdmin.HandleFunc("/login-event", handlers.GetLoginEvents).Methods("GET")
+
+	apiAdmin.HandleFunc("/storage/consistency", handlers.CheckStorageConsistency).Methods("POST")
+
+	apiAdmin.HandleFunc("/school", handlers.CreateSchool).Methods("POST")
+	apiAdmin.HandleFunc("/school/{id}", handlers.UpdateSchool).Methods("PATCH")
+	apiAdmin.HandleFunc("/school/{id}", handlers.DeleteSchool).Methods("DELETE")
+
+	apiAdmin.HandleFunc("/company", handlers.CreateCompany).Methods("POST")
+	apiAdmin.HandleFunc("/company/{id}", handlers.UpdateCompany).Methods("PATCH")
+	apiAdmin.HandleFunc("/company/{id}", handlers.DeleteCompany).Methods("DELETE")
+
+	apiAdmin.HandleFunc("/language", handlers.CreateLanguage).Methods("POST")
+	apiAdmin.HandleFunc("/language/{id}", handlers.UpdateLanguage).Methods("PATCH")
+	apiAdmin.HandleFunc("/language/{id}", handlers.DeleteLanguage).Methods("DELETE")
+
+	apiAdmin.HandleFunc("/skill", handlers.CreateSkill).Methods("POST")
+	apiAdmin.HandleFunc("/skill/{id}", handlers.UpdateSkill).Methods("PATCH")
+	apiAdmin.HandleFunc("/skill/{id}", handlers.DeleteSkill).Methods("DELETE")
+
+	apiAdmin.HandleFunc("/skill-translation", handlers.CreateSkillTranslation).Methods("POST")
+	apiAdmin.HandleFunc("/skill-translation/{skill_id}/{language_id}", handlers.UpdateSkillTranslation).Methods("PUT", "PATCH")
+	apiAdmin.HandleFunc("/skill-translation/{skill_id}/{language_id}", handlers.DeleteSkillTranslation).Methods("DELETE")
+
+	apiAdmin.HandleFunc("/project-platform", handlers.CreateProjectPlatform).Methods("POST")
+	apiAdmin.HandleFunc("/project-platform/{id}", handlers.UpdateProjectPlatform).Methods("PATCH")
+	apiAdmin.HandleFunc("/project-platform/{id}", handlers.DeleteProjectPlatform).Methods("DELETE")
+
+	apiAdmin.HandleFunc("/project-platform-translation", handlers.CreateProjectPlatformTranslation).Methods("POST")
+	apiAdmin.HandleFunc("/project-platform-translation/{project_platform_id}/{language_id}", handlers.UpdateProjectPlatformTranslation).Methods("PUT", "PATCH")
+	apiAdmin.HandleFunc("/project-platform-translation/{project_platform_id}/{language_id}", handlers.DeleteProjectPlatformTranslation).Methods("DELETE")
+	apiAdmin.Use(middlewares.JWTMiddleware, middlewares.RoleMiddleware(models.UserRoleAdmin))
 
 	log.Fatal(http.ListenAndServe(":"+port, r))
 }
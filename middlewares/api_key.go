@@ -0,0 +1,76 @@
+package middlewares
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
+)
+
+// verify api key and build claims of the key owner, role is left empty so admin route never accept api key
+func authenticateAPIKey(apiKey string) (config.JWTClaim, bool) {
+	apiKeyRepo := repositories.NewUserAPIKeyRepository()
+	userAPIKey, err := apiKeyRepo.ReadActiveByKeyHash(helper.HashToken(apiKey))
+	if err != nil {
+		return config.JWTClaim{}, false
+	}
+
+	user, err := repositories.NewUserRepository().Read(int64(userAPIKey.UserID))
+	if err != nil {
+		return config.JWTClaim{}, false
+	}
+
+	if err := apiKeyRepo.TouchLastUsed(userAPIKey.ID); err != nil {
+		log.Println("Failed to update api key last used: ", err)
+	}
+
+	return config.JWTClaim{
+		Id:        user.ID,
+		Username:  user.Username,
+		TokenType: config.TokenTypeAPIKey,
+		Scopes:    userAPIKey.ScopeList(),
+	}, true
+}
+
+// public route works without credential, api key is only read to unlock scoped content
+func OptionalAPIKeyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiKey, ok := helper.GetAPIKeyString(r)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, ok := authenticateAPIKey(apiKey)
+		if !ok {
+			helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "API key invalid")
+			return
+		}
+
+		next.ServeHTTP(w, helper.SetJWTClaim(r, claims))
+	})
+}
+
+// jwt access token always pass, api key need one of the given scopes, must run after JWTMiddleware
+func ScopeMiddleware(scopes ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			jwtClaim, err := helper.GetJWTClaim(r)
+			if err == nil && jwtClaim.TokenType == config.TokenTypeAccess {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			for _, scope := range scopes {
+				if helper.HasScope(r, scope) {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Forbidden")
+		})
+	}
+}
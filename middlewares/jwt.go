@@ -6,58 +6,71 @@ import (
 
 	"github.com/FRanggaY/personal-portfolio-api/config"
 	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/repositories"
 	"github.com/golang-jwt/jwt/v5"
 )
 
+// accept jwt access token or api key, route which not using ScopeMiddleware must only be reached by jwt
 func JWTMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		c, err := r.Cookie("token")
-		if err != nil {
-			if err == http.ErrNoCookie {
-				response := map[string]string{"message": "Unauthorized"}
-				helper.ResponseJSON(w, http.StatusUnauthorized, response)
+		// api key from X-API-Key header or bearer with api key prefix
+		if apiKey, ok := helper.GetAPIKeyString(r); ok {
+			claims, ok := authenticateAPIKey(apiKey)
+			if !ok {
+				helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "API key invalid")
 				return
 			}
+			next.ServeHTTP(w, helper.SetJWTClaim(r, claims))
+			return
 		}
-		// take token value
-		tokenString := c.Value
 
-		claims := &config.JWTClaim{}
-		// parsing token jwt
-		token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
-			return config.JWT_KEY, nil
-		})
+		// take token value from bearer header or cookie
+		tokenString, err := helper.GetTokenString(r)
+		if err != nil {
+			helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+			return
+		}
 
+		// parsing token jwt
+		claims, err := helper.ParseJWTClaim(tokenString)
 		if err != nil {
 			switch {
 			case errors.Is(err, jwt.ErrTokenMalformed):
 				// token invalid
-				response := map[string]string{"message": "Token invalid"}
-				helper.ResponseJSON(w, http.StatusUnauthorized, response)
+				helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Token invalid")
 				return
 			case errors.Is(err, jwt.ErrTokenSignatureInvalid):
 				// Invalid signature
-				response := map[string]string{"message": "Invalid signature"}
-				helper.ResponseJSON(w, http.StatusUnauthorized, response)
+				helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Invalid signature")
 				return
 			case errors.Is(err, jwt.ErrTokenExpired) || errors.Is(err, jwt.ErrTokenNotValidYet):
 				// Token is either expired or not active yet
-				response := map[string]string{"message": "Token is expired or not active yet"}
-				helper.ResponseJSON(w, http.StatusUnauthorized, response)
+				helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Token is expired or not active yet")
 				return
 			default:
-				// Token is either expired or not active yet
-				response := map[string]string{"message": "Couldn't handle this token"}
-				helper.ResponseJSON(w, http.StatusUnauthorized, response)
+				// Token is not valid
+				helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Couldn't handle this token")
 				return
 			}
 		}
 
-		if !token.Valid {
-			response := map[string]string{"message": "Unauthorized"}
-			helper.ResponseJSON(w, http.StatusUnauthorized, response)
+		// token without jti can not be revoked, mfa pending token only for second login step
+		if claims.ID == "" || claims.TokenType != config.TokenTypeAccess {
+			helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Token invalid")
 			return
 		}
-		next.ServeHTTP(w, r)
+
+		// check revocation list
+		revoked, err := repositories.NewRevokedTokenRepository().IsRevoked(claims.ID)
+		if err != nil {
+			helper.ResponseError(w, http.StatusInternalServerError, helper.ErrorCodeInternal, "Couldn't handle this token")
+			return
+		}
+		if revoked {
+			helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Token has been revoked")
+			return
+		}
+
+		next.ServeHTTP(w, helper.SetJWTClaim(r, claims))
 	})
 }
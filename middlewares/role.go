@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+)
+
+// RoleMiddleware only pass request from user with one of the given roles, must run after JWTMiddleware
+func RoleMiddleware(roles ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			jwtClaim, err := helper.GetJWTClaim(r)
+			if err != nil {
+				helper.ResponseError(w, http.StatusUnauthorized, helper.ErrorCodeUnauthorized, "Unauthorized")
+				return
+			}
+
+			for _, role := range roles {
+				if jwtClaim.Role == role {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			helper.ResponseError(w, http.StatusForbidden, helper.ErrorCodeForbidden, "Forbidden")
+		})
+	}
+}
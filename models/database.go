@@ -25,7 +25,8 @@ func ConnectDatabase() {
 	dbName := os.Getenv("DB_NAME")
 
 	dsn := dbUser + ":" + dbPass + "@tcp(" + dbHost + ":" + dbPort + ")/" + dbName + "?parseTime=true"
-	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	// translate duplicate key error to gorm.ErrDuplicatedKey
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Fatal("Error connecting to database: ", err)
 		panic(err)
@@ -33,6 +34,9 @@ func ConnectDatabase() {
 
 	err = db.AutoMigrate(
 		&Language{}, &User{},
+		&UserRefreshToken{}, &RevokedToken{}, &UserPasswordReset{},
+		&LoginAttempt{}, &LoginEvent{},
+		&UserMFA{}, &UserRecoveryCode{}, &UserAPIKey{},
 		&School{}, &Company{},
 		&Skill{}, &UserSkill{}, &SkillTranslation{},
 		&ProjectPlatform{}, &ProjectPlatformTranslation{},
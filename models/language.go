@@ -17,8 +17,8 @@ type LanguageResponse struct {
 
 type Language struct {
 	ID        int64     `gorm:"primaryKey" json:"id"`
-	Code      string    `gorm:"varchar;size:5;unique;not null" json:"code"`
-	Name      string    `gorm:"varchar;size:32;unique;not null" json:"name"`
+	Code      string    `gorm:"varchar;size:5;unique;not null" json:"code" validate:"required"`
+	Name      string    `gorm:"varchar;size:32;unique;not null" json:"name" validate:"required"`
 	LogoUrl   string    `gorm:"varchar;size:300" json:"logo_url"`
 	CreatedAt time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
 	UpdatedAt time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	LoginAttemptTypeUsername = "username"
+	LoginAttemptTypeIP       = "ip"
+	// password reset request, counted apart so it never lock the login of the user
+	LoginAttemptTypeResetUsername = "reset_username"
+	LoginAttemptTypeResetIP       = "reset_ip"
+)
+
+const (
+	LoginEventSuccess  = "success"
+	LoginEventFailed   = "failed"
+	LoginEventLocked   = "locked"
+	LoginEventUnlocked = "unlocked"
+)
+
+// failed login or password reset request counter per username or per ip
+type LoginAttempt struct {
+	ID           int64      `gorm:"primaryKey" json:"id"`
+	Type         string     `gorm:"varchar;not null;size:16;uniqueIndex:idx_login_attempt_type_value" json:"type"`
+	Value        string     `gorm:"varchar;not null;size:64;uniqueIndex:idx_login_attempt_type_value" json:"value"`
+	FailedCount  int        `gorm:"not null;default:0" json:"failed_count"`
+	LastFailedAt *time.Time `gorm:"type:timestamp(0)" json:"last_failed_at"`
+	LockedUntil  *time.Time `gorm:"type:timestamp(0);index" json:"locked_until"`
+	CreatedAt    time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+	UpdatedAt    time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
+}
+
+func (LoginAttempt) BeforeUpdate(db *gorm.DB) error {
+	// manually updated at
+	db.Statement.SetColumn("UpdatedAt", time.Now())
+	return nil
+}
+
+// login audit trail
+type LoginEvent struct {
+	ID        int64     `gorm:"primaryKey" json:"id"`
+	Username  string    `gorm:"varchar;not null;size:48;index" json:"username"`
+	IPAddress string    `gorm:"varchar;not null;size:64" json:"ip_address"`
+	Event     string    `gorm:"varchar;not null;size:16" json:"event"`
+	CreatedAt time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime;index" json:"created_at"`
+}
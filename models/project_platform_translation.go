@@ -22,11 +22,17 @@ type ProjectPlatformTranslationResponse struct {
 }
 
 type ProjectPlatformTranslationCreateForm struct {
-	LanguageID        int64  `gorm:"int64;not null" json:"language_id"`
-	ProjectPlatformID int64  `gorm:"int64;not null" json:"ProjectPlatform_id"`
-	Title             string `gorm:"varchar;not null;size:30" json:"title"`
-	Description       string `gorm:"varchar;not null;size:300" json:"description"`
+	LanguageID        int64  `gorm:"int64;not null" json:"language_id" validate:"required"`
+	ProjectPlatformID int64  `gorm:"int64;not null" json:"ProjectPlatform_id" validate:"required"`
+	Title             string `gorm:"varchar;not null;size:30" json:"title" validate:"required"`
+	Description       string `gorm:"varchar;not null;size:300" json:"description" validate:"required"`
 }
+
+type ProjectPlatformTranslationEditForm struct {
+	Title       *string `gorm:"varchar;size:30" json:"title" validate:"required"`
+	Description *string `gorm:"varchar;size:300" json:"description" validate:"required"`
+}
+
 type ProjectPlatformTranslation struct {
 	ID                int64     `gorm:"primaryKey" json:"id"`
 	ProjectPlatformID uint      // Foreign key to link user ProjectPlatform translation to ProjectPlatform
@@ -0,0 +1,14 @@
+package models
+
+import (
+	"time"
+)
+
+// access token jti that can not be used anymore until it expired
+type RevokedToken struct {
+	ID        int64     `gorm:"primaryKey" json:"id"`
+	JTI       string    `gorm:"varchar;unique;not null;size:32" json:"jti"`
+	UserID    int64     `gorm:"index" json:"user_id"`
+	ExpiresAt time.Time `gorm:"type:timestamp(0);index" json:"expires_at"`
+	CreatedAt time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+}
@@ -16,8 +16,8 @@ type SchoolAllResponse struct {
 
 type School struct {
 	ID                 int64     `gorm:"primaryKey" json:"id"`
-	Code               string    `gorm:"varchar;unique;not null;size:5" json:"code"`
-	Name               string    `gorm:"varchar;unique;not null;size:64" json:"name"`
+	Code               string    `gorm:"varchar;unique;not null;size:5" json:"code" validate:"required"`
+	Name               string    `gorm:"varchar;unique;not null;size:64" json:"name" validate:"required"`
 	ImageUrl           string    `gorm:"varchar;size:300" json:"image_url"`
 	Url                string    `gorm:"varchar;size:300" json:"url"`
 	IsExternalUrl      bool      `gorm:"boolean" json:"is_external_url"`
@@ -17,8 +17,8 @@ type SkillAllResponse struct {
 
 type Skill struct {
 	ID                 int64     `gorm:"primaryKey" json:"id"`
-	Code               string    `gorm:"varchar;unique;not null;size:5" json:"code"`
-	Name               string    `gorm:"varchar;unique;not null;size:48" json:"name"`
+	Code               string    `gorm:"varchar;unique;not null;size:5" json:"code" validate:"required"`
+	Name               string    `gorm:"varchar;unique;not null;size:48" json:"name" validate:"required"`
 	ImageUrl           string    `gorm:"varchar;size:300" json:"image_url"`
 	Url                string    `gorm:"varchar;size:300" json:"url"`
 	IsExternalUrl      bool      `gorm:"boolean" json:"is_external_url"`
@@ -18,12 +18,20 @@ type SkillTranslationResponse struct {
 	Description        string    `json:"description"`
 	CreatedAt          time.Time `json:"created_at"`
 	UpdatedAt          time.Time `json:"updated_at"`
+	// translation taken from fallback language, requested language has no translation
+	Fallback bool `gorm:"-" json:"fallback"`
+	// public url of image size variant, thumbnail, card and full
+	ImageSrcset map[string]string `gorm:"-" json:"image_srcset,omitempty"`
 }
 
 type SkillTranslationCreateForm struct {
-	LanguageID  int64  `gorm:"int64;not null" json:"language_id"`
-	SkillID     int64  `gorm:"int64;not null" json:"skill_id"`
-	Description string `gorm:"varchar;not null;size:300" json:"description"`
+	LanguageID  int64  `gorm:"int64;not null" json:"language_id" validate:"required"`
+	SkillID     int64  `gorm:"int64;not null" json:"skill_id" validate:"required"`
+	Description string `gorm:"varchar;not null;size:300" json:"description" validate:"required"`
+}
+
+type SkillTranslationEditForm struct {
+	Description *string `gorm:"varchar;size:300" json:"description" validate:"required"`
 }
 
 type SkillTranslation struct {
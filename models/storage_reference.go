@@ -0,0 +1,9 @@
+package models
+
+// row pointing to a stored image, path is the value of the image column
+type StorageReference struct {
+	Table  string `gorm:"-" json:"table"`
+	Column string `gorm:"-" json:"column"`
+	ID     int64  `json:"id"`
+	Path   string `json:"path"`
+}
@@ -0,0 +1,83 @@
+package models
+
+import "encoding/xml"
+
+const (
+	TranslationFormatJSON  = "json"
+	TranslationFormatXLIFF = "xliff"
+)
+
+// translatable field of every section, field name is column name and json name of translation model
+var TranslationExchangeFields = map[string][]string{
+	TranslationSectionExperience: {"title", "description", "industry"},
+	TranslationSectionEducation:  {"title", "description"},
+	TranslationSectionProject:    {"name", "description"},
+	TranslationSectionLanguage:   {"title", "description"},
+	TranslationSectionSkill:      {"description"},
+}
+
+// translation model of every section, used to read size limit
+var TranslationExchangeModels = map[string]interface{}{
+	TranslationSectionExperience: UserExperienceTranslation{},
+	TranslationSectionEducation:  UserEducationTranslation{},
+	TranslationSectionProject:    UserProjectTranslation{},
+	TranslationSectionLanguage:   UserLanguageTranslation{},
+	TranslationSectionSkill:      SkillTranslation{},
+}
+
+// one translatable string, id is "<section>/<item id>/<field>"
+type TranslationUnit struct {
+	ID        string `json:"id"`
+	Source    string `json:"source"`
+	Target    string `json:"target"`
+	MaxLength int    `json:"max_length"`
+}
+
+type TranslationDocument struct {
+	SourceLanguage string            `json:"source_language"`
+	TargetLanguage string            `json:"target_language"`
+	Units          []TranslationUnit `json:"units"`
+}
+
+type TranslationUnitError struct {
+	ID      string `json:"id"`
+	Message string `json:"message"`
+}
+
+// translation value of one field in one language
+type TranslationExchangeValue struct {
+	ItemID     int64
+	LanguageID int64
+	Value      string
+}
+
+// target translation values of one item
+type TranslationExchangeUpdate struct {
+	Section string
+	ItemID  int64
+	Values  map[string]string
+}
+
+// XLIFF 1.2 document
+type XLIFFDocument struct {
+	XMLName xml.Name  `xml:"xliff"`
+	Version string    `xml:"version,attr"`
+	Xmlns   string    `xml:"xmlns,attr,omitempty"`
+	File    XLIFFFile `xml:"file"`
+}
+
+type XLIFFFile struct {
+	Original       string           `xml:"original,attr"`
+	SourceLanguage string           `xml:"source-language,attr"`
+	TargetLanguage string           `xml:"target-language,attr"`
+	Datatype       string           `xml:"datatype,attr"`
+	TransUnits     []XLIFFTransUnit `xml:"body>trans-unit"`
+}
+
+type XLIFFTransUnit struct {
+	ID       string `xml:"id,attr"`
+	MaxWidth int    `xml:"maxwidth,attr,omitempty"`
+	SizeUnit string `xml:"size-unit,attr,omitempty"`
+	Source   string `xml:"source"`
+	Target   string `xml:"target"`
+}
@@ -0,0 +1,50 @@
+package models
+
+const (
+	TranslationSectionExperience = "experience"
+	TranslationSectionEducation  = "education"
+	TranslationSectionProject    = "project"
+	TranslationSectionLanguage   = "language"
+	TranslationSectionSkill      = "skill"
+)
+
+// section order of translation status report
+var TranslationSections = []string{
+	TranslationSectionExperience,
+	TranslationSectionEducation,
+	TranslationSectionProject,
+	TranslationSectionLanguage,
+	TranslationSectionSkill,
+}
+
+// translatable row of user, skill row is the master skill used by user
+type TranslationStatusItem struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+}
+
+// existing translation of translatable row
+type TranslationStatusPair struct {
+	ItemID     int64
+	LanguageID int64
+}
+
+type TranslationSectionStatus struct {
+	Section      string                  `json:"section"`
+	Total        int                     `json:"total"`
+	Translated   int                     `json:"translated"`
+	Missing      int                     `json:"missing"`
+	Percentage   float64                 `json:"percentage"`
+	MissingItems []TranslationStatusItem `json:"missing_items"`
+}
+
+type LanguageTranslationStatus struct {
+	LanguageID   int64                      `json:"language_id"`
+	LanguageCode string                     `json:"language_code"`
+	LanguageName string                     `json:"language_name"`
+	Total        int                        `json:"total"`
+	Translated   int                        `json:"translated"`
+	Missing      int                        `json:"missing"`
+	Percentage   float64                    `json:"percentage"`
+	Sections     []TranslationSectionStatus `json:"sections"`
+}
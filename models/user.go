@@ -6,15 +6,35 @@ import (
 	"gorm.io/gorm"
 )
 
+const (
+	UserRoleAdmin = "admin"
+	UserRoleOwner = "owner"
+)
+
+type UserAllResponse struct {
+	ID        int64     `json:"id"`
+	Username  string    `json:"username"`
+	Name      string    `json:"name"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
 type UserCreateForm struct {
-	Name     string `gorm:"varchar;not null;size:48" json:"name"`
-	Username string `gorm:"varchar;unique;not null;size:48" json:"username"`
-	Password string `gorm:"varchar;unique;not null;size:300" json:"password"`
+	Name     string `gorm:"varchar;not null;size:48" json:"name" validate:"required"`
+	Username string `gorm:"varchar;unique;not null;size:48" json:"username" validate:"required"`
+	Password string `gorm:"varchar;unique;not null;size:300" json:"password" validate:"required"`
 }
 
 type UserEditForm struct {
-	Name     string `gorm:"varchar;not null;size:48" json:"name"`
-	Username string `gorm:"varchar;unique;not null;size:48" json:"username"`
+	Name     string `gorm:"varchar;not null;size:48" json:"name" validate:"required"`
+	Username string `gorm:"varchar;unique;not null;size:48" json:"username" validate:"required"`
+	// nil keep current default language, 0 remove it
+	DefaultLanguageID *int64 `json:"default_language_id"`
+}
+
+type UserRoleEditForm struct {
+	Role string `gorm:"varchar;not null;size:16" json:"role"`
 }
 
 type UserLoginForm struct {
@@ -23,12 +43,15 @@ type UserLoginForm struct {
 }
 
 type User struct {
-	ID        int64     `gorm:"primaryKey" json:"id"`
-	Name      string    `gorm:"varchar;not null;size:48" json:"name"`
-	Username  string    `gorm:"varchar;unique;not null;size:48" json:"username"`
-	Password  string    `gorm:"varchar;unique;not null;size:300" json:"password"`
-	CreatedAt time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
-	UpdatedAt time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
+	ID       int64  `gorm:"primaryKey" json:"id"`
+	Name     string `gorm:"varchar;not null;size:48" json:"name"`
+	Username string `gorm:"varchar;unique;not null;size:48" json:"username"`
+	Password string `gorm:"varchar;unique;not null;size:300" json:"password"`
+	Role     string `gorm:"varchar;not null;size:16;default:'owner'" json:"role"`
+	// language served by public endpoint when requested language has no translation
+	DefaultLanguageID *int64    `gorm:"index" json:"default_language_id"`
+	CreatedAt         time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+	UpdatedAt         time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
 
 	Positions   []UserPosition   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Attachments []UserAttachment `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
@@ -36,6 +59,12 @@ type User struct {
 	Educations  []UserEducation  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Languages   []UserLanguage   `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 	Projects    []UserProject    `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+
+	RefreshTokens  []UserRefreshToken  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	PasswordResets []UserPasswordReset `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	MFA            *UserMFA            `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	RecoveryCodes  []UserRecoveryCode  `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	APIKeys        []UserAPIKey        `gorm:"constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
 func (User) BeforeUpdate(db *gorm.DB) error {
@@ -0,0 +1,60 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// include inactive (draft) rows on public endpoint of the key owner
+	APIKeyScopeReadDrafts = "read:drafts"
+	// manage user project, project translation and project attachment
+	APIKeyScopeWriteProjects = "write:projects"
+)
+
+var APIKeyScopes = []string{APIKeyScopeReadDrafts, APIKeyScopeWriteProjects}
+
+type UserAPIKeyCreateForm struct {
+	Name   string   `gorm:"varchar;not null;size:64" json:"name" validate:"required"`
+	Scopes []string `json:"scopes"`
+}
+
+// api key only saved as sha256 hash, prefix is kept to recognize the key on list
+type UserAPIKey struct {
+	ID         int64      `gorm:"primaryKey" json:"id"`
+	UserID     uint       `gorm:"index" json:"user_id"` // Foreign key to link api key to user
+	Name       string     `gorm:"varchar;not null;size:64" json:"name"`
+	Prefix     string     `gorm:"varchar;not null;size:16" json:"prefix"`
+	KeyHash    string     `gorm:"varchar;unique;not null;size:64" json:"-"`
+	Scopes     string     `gorm:"varchar;not null;size:255" json:"-"`
+	LastUsedAt *time.Time `gorm:"type:timestamp(0)" json:"last_used_at"`
+	RevokedAt  *time.Time `gorm:"type:timestamp(0)" json:"revoked_at"`
+	CreatedAt  time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+	UpdatedAt  time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
+}
+
+func (UserAPIKey) BeforeUpdate(db *gorm.DB) error {
+	// manually updated at
+	db.Statement.SetColumn("UpdatedAt", time.Now())
+	return nil
+}
+
+// scopes saved as comma separated value
+func (apiKey UserAPIKey) ScopeList() []string {
+	if apiKey.Scopes == "" {
+		return []string{}
+	}
+	return strings.Split(apiKey.Scopes, ",")
+}
+
+type UserAPIKeyResponse struct {
+	ID         int64      `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
@@ -9,7 +9,7 @@ import (
 type UserAttachment struct {
 	ID                 int64     `gorm:"primaryKey" json:"id"`
 	UserID             uint      // Foreign key to link user attachment to user
-	Title              string    `gorm:"varchar;not null;size:64" json:"title"`
+	Title              string    `gorm:"varchar;not null;size:64" json:"title" validate:"required"`
 	Category           string    `gorm:"varchar;size:36" json:"category"`
 	ImageUrl           string    `gorm:"varchar;size:300" json:"image_url"`
 	Url                string    `gorm:"varchar;size:300" json:"url"`
@@ -27,16 +27,28 @@ type EducationTranslationResponse struct {
 	SchoolIsExternalImageUrl bool      `gorm:"boolean" json:"school_is_external_image_url"`
 	CreatedAt                time.Time `json:"created_at"`
 	UpdatedAt                time.Time `json:"updated_at"`
+	// translation taken from fallback language, requested language has no translation
+	Fallback bool `gorm:"-" json:"fallback"`
+	// public url of image size variant, thumbnail, card and full
+	SchoolImageSrcset map[string]string `gorm:"-" json:"school_image_srcset,omitempty"`
 }
 
 type UserEducationTranslationCreateForm struct {
-	LanguageID   int64  `gorm:"int64;not null" json:"language_id"`
-	SchoolID     int64  `gorm:"int64;not null" json:"school_id"`
-	Title        string `gorm:"varchar;not null;size:64" json:"title"`
-	Description  string `gorm:"varchar;not null;size:300" json:"description"`
-	Category     string `gorm:"varchar;not null;size:14" json:"category"`
-	Location     string `gorm:"varchar;not null;size:128" json:"location"`
-	LocationType string `gorm:"varchar;not null;size:36" json:"location_type"`
+	LanguageID   int64  `gorm:"int64;not null" json:"language_id" validate:"required"`
+	SchoolID     int64  `gorm:"int64;not null" json:"school_id" validate:"required"`
+	Title        string `gorm:"varchar;not null;size:64" json:"title" validate:"required"`
+	Description  string `gorm:"varchar;not null;size:300" json:"description" validate:"required"`
+	Category     string `gorm:"varchar;not null;size:14" json:"category" validate:"required,oneof=formal non_formal course bootcamp certification" enums:"formal,non_formal,course,bootcamp,certification"`
+	Location     string `gorm:"varchar;not null;size:128" json:"location" validate:"required"`
+	LocationType string `gorm:"varchar;not null;size:36" json:"location_type" validate:"required,oneof=on_site hybrid remote" enums:"on_site,hybrid,remote"`
+}
+
+type UserEducationTranslationEditForm struct {
+	Title        *string `gorm:"varchar;size:64" json:"title" validate:"required"`
+	Description  *string `gorm:"varchar;size:300" json:"description" validate:"required"`
+	Category     *string `gorm:"varchar;size:14" json:"category" validate:"required,oneof=formal non_formal course bootcamp certification" enums:"formal,non_formal,course,bootcamp,certification"`
+	Location     *string `gorm:"varchar;size:128" json:"location" validate:"required"`
+	LocationType *string `gorm:"varchar;size:36" json:"location_type" validate:"required,oneof=on_site hybrid remote" enums:"on_site,hybrid,remote"`
 }
 
 type UserEducationTranslation struct {
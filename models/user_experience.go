@@ -3,16 +3,31 @@ package models
 import (
 	"time"
 
+	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"gorm.io/gorm"
 )
 
 type UserExperienceCreateForm struct {
-	CompanyID  int64 `gorm:"int64;not null" json:"company_id"`
-	MonthStart int   `gorm:"int;not null;size:2" json:"month_start"`
-	MonthEnd   int   `gorm:"int;size:2" json:"month_end"`
-	YearStart  int64 `gorm:"int64;not null;size:4" json:"year_start"`
-	YearEnd    int64 `gorm:"int64;size:4" json:"year_end"`
+	CompanyID  int64 `gorm:"int64;not null" json:"company_id" validate:"required"`
+	MonthStart int   `gorm:"int;not null;size:2" json:"month_start" validate:"required,min=1,max=12"`
+	MonthEnd   int   `gorm:"int;size:2" json:"month_end" validate:"omitempty,min=1,max=12"`
+	YearStart  int64 `gorm:"int64;not null;size:4" json:"year_start" validate:"required,min=1900,max=9999"`
+	YearEnd    int64 `gorm:"int64;size:4" json:"year_end" validate:"omitempty,min=1900,max=9999,gtefield=YearStart"`
 }
+
+// end period must not be before start period
+func (form UserExperienceCreateForm) ValidateForm() []helper.FieldError {
+	return helper.ValidatePeriod(form.MonthStart, form.YearStart, form.MonthEnd, form.YearEnd)
+}
+
+type UserExperienceEditForm struct {
+	MonthStart *int   `gorm:"int;size:2" json:"month_start" validate:"min=1,max=12"`
+	MonthEnd   *int   `gorm:"int;size:2" json:"month_end" validate:"omitempty,min=1,max=12"`
+	YearStart  *int64 `gorm:"int64;size:4" json:"year_start" validate:"min=1900,max=9999"`
+	YearEnd    *int64 `gorm:"int64;size:4" json:"year_end" validate:"omitempty,min=1900,max=9999"`
+	IsActive   *bool  `gorm:"bool" json:"is_active"`
+}
+
 type UserExperience struct {
 	ID         int64     `gorm:"primaryKey" json:"id"`
 	UserID     uint      // Foreign key to link user experience to user
@@ -28,17 +28,30 @@ type ExperienceTranslationResponse struct {
 	CompanyIsExternalImageUrl bool      `gorm:"boolean" json:"company_is_external_image_url"`
 	CreatedAt                 time.Time `json:"created_at"`
 	UpdatedAt                 time.Time `json:"updated_at"`
+	// translation taken from fallback language, requested language has no translation
+	Fallback bool `gorm:"-" json:"fallback"`
+	// public url of image size variant, thumbnail, card and full
+	CompanyImageSrcset map[string]string `gorm:"-" json:"company_image_srcset,omitempty"`
 }
 
 type UserExperienceTranslationCreateForm struct {
-	LanguageID   int64  `gorm:"int64;not null" json:"language_id"`
-	CompanyID    int64  `gorm:"int64;not null" json:"company_id"`
-	Title        string `gorm:"varchar;not null;size:64" json:"title"`
-	Description  string `gorm:"varchar;not null;size:300" json:"description"`
-	Category     string `gorm:"varchar;not null;size:14" json:"category"`
-	Location     string `gorm:"varchar;not null;size:128" json:"location"`
-	LocationType string `gorm:"varchar;not null;size:36" json:"location_type"`
-	Industry     string `gorm:"varchar;not null;size:300" json:"industry"`
+	LanguageID   int64  `gorm:"int64;not null" json:"language_id" validate:"required"`
+	CompanyID    int64  `gorm:"int64;not null" json:"company_id" validate:"required"`
+	Title        string `gorm:"varchar;not null;size:64" json:"title" validate:"required"`
+	Description  string `gorm:"varchar;not null;size:300" json:"description" validate:"required"`
+	Category     string `gorm:"varchar;not null;size:14" json:"category" validate:"required,oneof=full_time part_time self_employed freelance contract internship apprenticeship seasonal" enums:"full_time,part_time,self_employed,freelance,contract,internship,apprenticeship,seasonal"`
+	Location     string `gorm:"varchar;not null;size:128" json:"location" validate:"required"`
+	LocationType string `gorm:"varchar;not null;size:36" json:"location_type" validate:"required,oneof=on_site hybrid remote" enums:"on_site,hybrid,remote"`
+	Industry     string `gorm:"varchar;not null;size:300" json:"industry" validate:"required"`
+}
+
+type UserExperienceTranslationEditForm struct {
+	Title        *string `gorm:"varchar;size:64" json:"title" validate:"required"`
+	Description  *string `gorm:"varchar;size:300" json:"description" validate:"required"`
+	Category     *string `gorm:"varchar;size:14" json:"category" validate:"required,oneof=full_time part_time self_employed freelance contract internship apprenticeship seasonal" enums:"full_time,part_time,self_employed,freelance,contract,internship,apprenticeship,seasonal"`
+	Location     *string `gorm:"varchar;size:128" json:"location" validate:"required"`
+	LocationType *string `gorm:"varchar;size:36" json:"location_type" validate:"required,oneof=on_site hybrid remote" enums:"on_site,hybrid,remote"`
+	Industry     *string `gorm:"varchar;size:300" json:"industry" validate:"required"`
 }
 
 type UserExperienceTranslation struct {
@@ -7,7 +7,11 @@ import (
 )
 
 type UserLanguageCreateForm struct {
-	LanguageID int64 `gorm:"int64;not null" json:"language_id"`
+	LanguageID int64 `gorm:"int64;not null" json:"language_id" validate:"required"`
+}
+
+type UserLanguageEditForm struct {
+	IsActive *bool `gorm:"bool" json:"is_active"`
 }
 
 type UserLanguage struct {
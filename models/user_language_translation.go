@@ -8,6 +8,7 @@ import (
 
 type UserLanguageTranslationResponse struct {
 	ID          int64     `json:"id"`
+	LanguageID  int64     `json:"language_id"`
 	Code        string    `json:"code"`
 	Name        string    `json:"name"`
 	Title       string    `json:"title"`
@@ -15,13 +16,20 @@ type UserLanguageTranslationResponse struct {
 	LogoUrl     string    `json:"logo_url"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	// translation taken from fallback language, requested language has no translation
+	Fallback bool `gorm:"-" json:"fallback"`
 }
 
 type UserLanguageTranslationCreateForm struct {
-	SelectLanguageID int64  `gorm:"int64;not null" json:"select_language_id"`
-	LanguageID       int64  `gorm:"int64;not null" json:"language_id"`
-	Title            string `gorm:"varchar;not null;size:30" json:"title"`
-	Description      string `gorm:"varchar;not null;size:300" json:"description"`
+	SelectLanguageID int64  `gorm:"int64;not null" json:"select_language_id" validate:"required"`
+	LanguageID       int64  `gorm:"int64;not null" json:"language_id" validate:"required"`
+	Title            string `gorm:"varchar;not null;size:30" json:"title" validate:"required"`
+	Description      string `gorm:"varchar;not null;size:300" json:"description" validate:"required"`
+}
+
+type UserLanguageTranslationEditForm struct {
+	Title       *string `gorm:"varchar;size:30" json:"title" validate:"required"`
+	Description *string `gorm:"varchar;size:300" json:"description" validate:"required"`
 }
 
 type UserLanguageTranslation struct {
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type UserMFACodeForm struct {
+	Code string `json:"code"`
+}
+
+type UserMFADisableForm struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+type UserMFALoginForm struct {
+	MFAToken string `json:"mfa_token"`
+	// totp code or recovery code
+	Code string `json:"code"`
+}
+
+// totp secret of user, enabled after first code verified
+type UserMFA struct {
+	ID              int64      `gorm:"primaryKey" json:"id"`
+	UserID          uint       `gorm:"uniqueIndex" json:"user_id"` // Foreign key to link mfa to user
+	Secret          string     `gorm:"varchar;not null;size:64" json:"-"`
+	IsEnabled       bool       `gorm:"bool;default:0" json:"is_enabled"`
+	LastUsedCounter int64      `gorm:"not null;default:0" json:"-"`
+	EnabledAt       *time.Time `gorm:"type:timestamp(0)" json:"enabled_at"`
+	CreatedAt       time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+	UpdatedAt       time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
+}
+
+func (UserMFA) BeforeUpdate(db *gorm.DB) error {
+	// manually updated at
+	db.Statement.SetColumn("UpdatedAt", time.Now())
+	return nil
+}
+
+// recovery code only saved as sha256 hash and can be used once
+type UserRecoveryCode struct {
+	ID        int64      `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"index" json:"user_id"` // Foreign key to link recovery code to user
+	CodeHash  string     `gorm:"varchar;unique;not null;size:64" json:"-"`
+	UsedAt    *time.Time `gorm:"type:timestamp(0)" json:"used_at"`
+	CreatedAt time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+}
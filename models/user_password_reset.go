@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type UserPasswordChangeForm struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+type UserPasswordForgotForm struct {
+	Username string `json:"username"`
+}
+
+type UserPasswordResetForm struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"new_password"`
+}
+
+// reset token only saved as sha256 hash and can be used once
+type UserPasswordReset struct {
+	ID        int64      `gorm:"primaryKey" json:"id"`
+	UserID    uint       `gorm:"index" json:"user_id"` // Foreign key to link password reset to user
+	TokenHash string     `gorm:"varchar;unique;not null;size:64" json:"-"`
+	ExpiresAt time.Time  `gorm:"type:timestamp(0)" json:"expires_at"`
+	UsedAt    *time.Time `gorm:"type:timestamp(0)" json:"used_at"`
+	CreatedAt time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
+}
+
+func (UserPasswordReset) BeforeUpdate(db *gorm.DB) error {
+	// manually updated at
+	db.Statement.SetColumn("UpdatedAt", time.Now())
+	return nil
+}
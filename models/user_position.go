@@ -7,7 +7,12 @@ import (
 )
 
 type UserPositionCreateForm struct {
-	Title string `gorm:"varchar;not null;size:64" json:"title"`
+	Title string `gorm:"varchar;not null;size:64" json:"title" validate:"required"`
+}
+
+type UserPositionEditForm struct {
+	Title    *string `gorm:"varchar;size:64" json:"title" validate:"required"`
+	IsActive *bool   `gorm:"bool" json:"is_active"`
 }
 
 type UserPosition struct {
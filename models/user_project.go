@@ -10,7 +10,7 @@ type UserProject struct {
 	ID                int64     `gorm:"primaryKey" json:"id"`
 	UserID            uint      // Foreign key
 	ProjectPlatformID uint      // Foregin Key
-	Slug              string    `gorm:"varchar;not null;size:126" json:"slug"`
+	Slug              string    `gorm:"varchar;not null;size:126" json:"slug" validate:"required"`
 	ProjectCreatedAt  time.Time `gorm:"default:current_timestamp;type:timestamp(0);" json:"project_created_at"`
 	ProjectUpdatedAt  time.Time `gorm:"default:current_timestamp;type:timestamp(0);" json:"project_updated_at"`
 	ImageUrl          string    `gorm:"varchar;size:300" json:"image_url"`
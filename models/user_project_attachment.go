@@ -9,7 +9,7 @@ import (
 type UserProjectAttachment struct {
 	ID                 int64     `gorm:"primaryKey" json:"id"`
 	UserProjectID      uint      // Foreign key
-	Title              string    `gorm:"varchar;not null;size:64" json:"title"`
+	Title              string    `gorm:"varchar;not null;size:64" json:"title" validate:"required"`
 	Category           string    `gorm:"varchar;size:36" json:"category"`
 	ImageUrl           string    `gorm:"varchar;size:300" json:"image_url"`
 	Url                string    `gorm:"varchar;size:300" json:"url"`
@@ -18,23 +18,41 @@ type ProjectTranslationResponse struct {
 	ProjectUpdatedAt  time.Time `json:"project_updated_at"`
 	CreatedAt         time.Time `json:"created_at"`
 	UpdatedAt         time.Time `json:"updated_at"`
+	// draft from machine translation, not approved by human yet
+	MachineTranslated bool `json:"machine_translated"`
+	// translation taken from fallback language, requested language has no translation
+	Fallback bool `gorm:"-" json:"fallback"`
+	// public url of image size variant, thumbnail, card and full
+	ImageSrcset map[string]string `gorm:"-" json:"image_srcset,omitempty"`
 }
 
 type UserProjectTranslationCreateForm struct {
-	LanguageID    int64  `gorm:"int64;not null" json:"language_id"`
-	UserProjectID int64  `gorm:"int64;not null" json:"user_project_id"`
-	Name          string `gorm:"varchar;not null;size:48" json:"name"`
-	Description   string `gorm:"varchar;not null;size:300" json:"description"`
+	LanguageID    int64  `gorm:"int64;not null" json:"language_id" validate:"required"`
+	UserProjectID int64  `gorm:"int64;not null" json:"user_project_id" validate:"required"`
+	Name          string `gorm:"varchar;not null;size:48" json:"name" validate:"required"`
+	Description   string `gorm:"varchar;not null;size:300" json:"description" validate:"required"`
+}
+
+type UserProjectTranslationEditForm struct {
+	Name        *string `gorm:"varchar;size:48" json:"name" validate:"required"`
+	Description *string `gorm:"varchar;size:300" json:"description" validate:"required"`
+}
+
+// create draft translation in every language which has no translation yet
+type UserProjectMachineTranslateForm struct {
+	SourceLanguageID int64 `json:"source_language_id" validate:"required"`
 }
 
 type UserProjectTranslation struct {
-	ID            int64     `gorm:"primaryKey" json:"id"`
-	LanguageID    uint      // Foreign key
-	UserProjectID uint      // Foreign key
-	Name          string    `gorm:"varchar;not null;size:48" json:"name"`
-	Description   string    `gorm:"varchar;not null;size:300" json:"description"`
-	CreatedAt     time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
-	UpdatedAt     time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
+	ID            int64  `gorm:"primaryKey" json:"id"`
+	LanguageID    uint   // Foreign key
+	UserProjectID uint   // Foreign key
+	Name          string `gorm:"varchar;not null;size:48" json:"name"`
+	Description   string `gorm:"varchar;not null;size:300" json:"description"`
+	// draft from machine translation, cleared when human update or approve the translation
+	MachineTranslated bool      `gorm:"not null;default:false" json:"machine_translated"`
+	CreatedAt         time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+	UpdatedAt         time.Time `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
 }
 
 func (UserProjectTranslation) BeforeUpdate(db *gorm.DB) error {
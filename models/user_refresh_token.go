@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type RefreshTokenForm struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// refresh token only saved as sha256 hash, every rotation keep the same family id
+type UserRefreshToken struct {
+	ID                   int64      `gorm:"primaryKey" json:"id"`
+	UserID               uint       `gorm:"index" json:"user_id"` // Foreign key to link refresh token to user
+	FamilyID             string     `gorm:"varchar;not null;size:32;index" json:"family_id"`
+	TokenHash            string     `gorm:"varchar;unique;not null;size:64" json:"-"`
+	AccessTokenID        string     `gorm:"varchar;not null;size:32" json:"-"`
+	AccessTokenExpiresAt time.Time  `gorm:"type:timestamp(0)" json:"-"`
+	ExpiresAt            time.Time  `gorm:"type:timestamp(0)" json:"expires_at"`
+	RevokedAt            *time.Time `gorm:"type:timestamp(0)" json:"revoked_at"`
+	CreatedAt            time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoCreateTime" json:"created_at"`
+	UpdatedAt            time.Time  `gorm:"default:current_timestamp;type:timestamp(0);autoUpdateTime" json:"updated_at"`
+}
+
+func (UserRefreshToken) BeforeUpdate(db *gorm.DB) error {
+	// manually updated at
+	db.Statement.SetColumn("UpdatedAt", time.Now())
+	return nil
+}
@@ -7,7 +7,11 @@ import (
 )
 
 type UserSkillCreateForm struct {
-	SkillID int64 `gorm:"int64;not null" json:"skill_id"`
+	SkillID int64 `gorm:"int64;not null" json:"skill_id" validate:"required"`
+}
+
+type UserSkillEditForm struct {
+	IsActive *bool `gorm:"bool" json:"is_active"`
 }
 
 type UserSkill struct {
@@ -0,0 +1,36 @@
+package notifiers
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+var fileNotifierLock sync.Mutex
+
+// append reset token to a file, only for local development
+type FileNotifier struct {
+	Path string
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{Path: path}
+}
+
+func (notifier *FileNotifier) SendPasswordReset(message PasswordResetMessage) error {
+	fileNotifierLock.Lock()
+	defer fileNotifierLock.Unlock()
+
+	file, err := os.OpenFile(notifier.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	line := fmt.Sprintf("%s\tpassword_reset\t%s\t%s\t%s\n", time.Now().Format(time.RFC3339), message.Username, message.Token, message.ExpiresAt.Format(time.RFC3339))
+	if _, err := file.WriteString(line); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package notifiers
+
+import (
+	"log"
+	"time"
+)
+
+// write reset token to application log, only for local development
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (notifier *LogNotifier) SendPasswordReset(message PasswordResetMessage) error {
+	log.Printf("password reset token for %s: %s (expired at %s)", message.Username, message.Token, message.ExpiresAt.Format(time.RFC3339))
+	return nil
+}
@@ -0,0 +1,29 @@
+package notifiers
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+type PasswordResetMessage struct {
+	UserID    int64
+	Username  string
+	Token     string
+	ExpiresAt time.Time
+}
+
+// deliver password reset token to the user, implementation can be log, file, email, etc
+type Notifier interface {
+	SendPasswordReset(message PasswordResetMessage) error
+}
+
+// choose notifier from configuration, default to log
+func NewNotifier(resetConfig config.PasswordResetConfig) Notifier {
+	switch resetConfig.Notifier {
+	case "file":
+		return NewFileNotifier(resetConfig.NotifierFile)
+	default:
+		return NewLogNotifier()
+	}
+}
@@ -1,25 +1,29 @@
 package repositories
 
 import (
+	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type CompanyRepository struct{}
+type CompanyRepository struct {
+	db *gorm.DB
+}
 
 func NewCompanyRepository() *CompanyRepository {
-	return &CompanyRepository{}
+	return &CompanyRepository{db: models.DB}
 }
 
 func (repo *CompanyRepository) Create(newData *models.Company) (*models.Company, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
 }
 
-func (repo *CompanyRepository) Count() (int, error) {
+func (repo *CompanyRepository) Count(listQuery helper.ListQuery) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.Company{})
+	query := applyListFilter(repo.db.Model(&models.Company{}), listQuery, masterDataSearchColumns)
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
@@ -28,30 +32,19 @@ func (repo *CompanyRepository) Count() (int, error) {
 
 func (repo *CompanyRepository) ReadAll() ([]models.Company, error) {
 	var datas []models.Company
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
 }
 
-func (repo *CompanyRepository) ReadFilteredPaginated(pageSize, pageNumber int) ([]models.Company, error) {
+func (repo *CompanyRepository) ReadFilteredPaginated(listQuery helper.ListQuery) ([]models.Company, error) {
 	var datas []models.Company
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB
+	query := applyListFilter(repo.db, listQuery, masterDataSearchColumns)
+	query = applyPage(query, listQuery.Sort, "id", listQuery.Order == helper.SortOrderDesc, listQuery.Pagination)
 
-	// pagination
-	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
+	if err := query.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -59,7 +52,7 @@ func (repo *CompanyRepository) ReadFilteredPaginated(pageSize, pageNumber int) (
 
 func (repo *CompanyRepository) Read(ID int64) (*models.Company, error) {
 	var data models.Company
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -67,7 +60,7 @@ func (repo *CompanyRepository) Read(ID int64) (*models.Company, error) {
 
 func (repo *CompanyRepository) ReadByCode(code string) (*models.Company, error) {
 	var data models.Company
-	if err := models.DB.Where("code = ?", code).First(&data).Error; err != nil {
+	if err := repo.db.Where("code = ?", code).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -75,16 +68,40 @@ func (repo *CompanyRepository) ReadByCode(code string) (*models.Company, error)
 
 func (repo *CompanyRepository) ReadByName(name string) (*models.Company, error) {
 	var data models.Company
-	if err := models.DB.Where("name = ?", name).First(&data).Error; err != nil {
+	if err := repo.db.Where("name = ?", name).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
-func (repo *CompanyRepository) ReadByNameOrCode(name string, code string) (*models.Company, error) {
-	var data models.Company
-	if err := models.DB.Where("name = ? OR code = ?", name, code).First(&data).Error; err != nil {
+// check name or code already used by other row, exceptID 0 check every row
+func (repo *CompanyRepository) ExistsByNameOrCode(name string, code string, exceptID int64) (bool, error) {
+	var count int64
+	if err := repo.db.Model(&models.Company{}).Where("(name = ? OR code = ?) AND id <> ?", name, code, exceptID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *CompanyRepository) Update(updatedData *models.Company) (*models.Company, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
 		return nil, err
 	}
-	return &data, nil
+	return updatedData, nil
+}
+
+func (repo *CompanyRepository) Delete(ID int64) error {
+	if err := repo.db.Delete(&models.Company{}, ID).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// count user owned rows that still reference the company
+func (repo *CompanyRepository) CountReferences(ID int64) (int, error) {
+	var count int64
+	if err := repo.db.Table("user_experiences").Where("company_id = ?", ID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
 }
@@ -1,25 +1,29 @@
 package repositories
 
 import (
+	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type LanguageRepository struct{}
+type LanguageRepository struct {
+	db *gorm.DB
+}
 
 func NewLanguageRepository() *LanguageRepository {
-	return &LanguageRepository{}
+	return &LanguageRepository{db: models.DB}
 }
 
 func (repo *LanguageRepository) Create(newData *models.Language) (*models.Language, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
 }
 
-func (repo *LanguageRepository) Count() (int, error) {
+func (repo *LanguageRepository) Count(listQuery helper.ListQuery) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.Language{})
+	query := applyListFilter(repo.db.Model(&models.Language{}), listQuery, masterDataSearchColumns)
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
@@ -28,30 +32,19 @@ func (repo *LanguageRepository) Count() (int, error) {
 
 func (repo *LanguageRepository) ReadAll() ([]models.Language, error) {
 	var datas []models.Language
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
 }
 
-func (repo *LanguageRepository) ReadFilteredPaginated(pageSize, pageNumber int) ([]models.Language, error) {
+func (repo *LanguageRepository) ReadFilteredPaginated(listQuery helper.ListQuery) ([]models.Language, error) {
 	var datas []models.Language
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB
+	query := applyListFilter(repo.db, listQuery, masterDataSearchColumns)
+	query = applyPage(query, listQuery.Sort, "id", listQuery.Order == helper.SortOrderDesc, listQuery.Pagination)
 
-	// pagination
-	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
+	if err := query.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -59,7 +52,7 @@ func (repo *LanguageRepository) ReadFilteredPaginated(pageSize, pageNumber int)
 
 func (repo *LanguageRepository) Read(ID int64) (*models.Language, error) {
 	var data models.Language
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -67,7 +60,7 @@ func (repo *LanguageRepository) Read(ID int64) (*models.Language, error) {
 
 func (repo *LanguageRepository) ReadByCode(code string) (*models.Language, error) {
 	var data models.Language
-	if err := models.DB.Where("code = ?", code).First(&data).Error; err != nil {
+	if err := repo.db.Where("code = ?", code).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -75,16 +68,54 @@ func (repo *LanguageRepository) ReadByCode(code string) (*models.Language, error
 
 func (repo *LanguageRepository) ReadByName(name string) (*models.Language, error) {
 	var data models.Language
-	if err := models.DB.Where("name = ?", name).First(&data).Error; err != nil {
+	if err := repo.db.Where("name = ?", name).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
-func (repo *LanguageRepository) ReadByNameOrCode(name string, code string) (*models.Language, error) {
-	var data models.Language
-	if err := models.DB.Where("name = ? OR code = ?", name, code).First(&data).Error; err != nil {
+// check name or code already used by other row, exceptID 0 check every row
+func (repo *LanguageRepository) ExistsByNameOrCode(name string, code string, exceptID int64) (bool, error) {
+	var count int64
+	if err := repo.db.Model(&models.Language{}).Where("(name = ? OR code = ?) AND id <> ?", name, code, exceptID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *LanguageRepository) Update(updatedData *models.Language) (*models.Language, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
 		return nil, err
 	}
-	return &data, nil
+	return updatedData, nil
+}
+
+func (repo *LanguageRepository) Delete(ID int64) error {
+	if err := repo.db.Delete(&models.Language{}, ID).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// count user owned and master data translation rows that still reference the language, all of them cascade on delete
+func (repo *LanguageRepository) CountReferences(ID int64) (int, error) {
+	tables := []string{
+		"user_languages",
+		"user_language_translations",
+		"user_experience_translations",
+		"user_education_translations",
+		"user_project_translations",
+		"skill_translations",
+		"project_platform_translations",
+	}
+
+	var total int64
+	for _, table := range tables {
+		var count int64
+		if err := repo.db.Table(table).Where(helper.FilterLanguageIDEqual, ID).Count(&count).Error; err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return int(total), nil
 }
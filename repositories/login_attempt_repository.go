@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LoginAttemptRepository struct {
+	db *gorm.DB
+}
+
+func NewLoginAttemptRepository() *LoginAttemptRepository {
+	return &LoginAttemptRepository{db: models.DB}
+}
+
+func (repo *LoginAttemptRepository) Read(ID int64) (*models.LoginAttempt, error) {
+	var data models.LoginAttempt
+	if err := repo.db.First(&data, ID).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+func (repo *LoginAttemptRepository) ReadByTypeValue(attemptType, value string) (*models.LoginAttempt, error) {
+	var data models.LoginAttempt
+	if err := repo.db.Where("type = ? AND value = ?", attemptType, value).First(&data).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// increase failed counter and set backoff, row is locked so concurrent attempts are counted
+func (repo *LoginAttemptRepository) RegisterFailure(attemptType, value string, maxAttempts int, throttle config.LoginThrottleConfig) (*models.LoginAttempt, bool, error) {
+	var data models.LoginAttempt
+	var locked bool
+	now := time.Now()
+
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		newData := models.LoginAttempt{Type: attemptType, Value: value}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&newData).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("type = ? AND value = ?", attemptType, value).
+			First(&data).Error; err != nil {
+			return err
+		}
+
+		// start again when last failure is out of window
+		if data.LastFailedAt != nil && now.Sub(*data.LastFailedAt) > throttle.AttemptWindow {
+			data.FailedCount = 0
+		}
+		data.FailedCount++
+		data.LastFailedAt = &now
+
+		var delay time.Duration
+		delay, locked = helper.LoginBackoff(data.FailedCount, throttle.FreeAttempts, maxAttempts, throttle.BaseDelay, throttle.LockoutDuration)
+		data.LockedUntil = nil
+		if delay > 0 {
+			lockedUntil := now.Add(delay)
+			data.LockedUntil = &lockedUntil
+		}
+
+		return tx.Save(&data).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return &data, locked, nil
+}
+
+// clear failed counter and lock, used on success login and admin unlock
+func (repo *LoginAttemptRepository) Reset(ID int64) error {
+	if err := repo.db.Model(&models.LoginAttempt{}).Where("id = ?", ID).Updates(map[string]interface{}{
+		"failed_count":   0,
+		"last_failed_at": nil,
+		"locked_until":   nil,
+	}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *LoginAttemptRepository) ResetByTypeValue(attemptType, value string) error {
+	if err := repo.db.Model(&models.LoginAttempt{}).Where("type = ? AND value = ?", attemptType, value).Updates(map[string]interface{}{
+		"failed_count":   0,
+		"last_failed_at": nil,
+		"locked_until":   nil,
+	}).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *LoginAttemptRepository) CountLocked(attemptType string) (int, error) {
+	var count int64
+	query := repo.db.Model(&models.LoginAttempt{}).Where("locked_until > ?", time.Now())
+	if attemptType != "" {
+		query = query.Where("type = ?", attemptType)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (repo *LoginAttemptRepository) ReadLockedPaginated(attemptType string, pagination helper.Pagination) ([]models.LoginAttempt, error) {
+	var datas []models.LoginAttempt
+
+	query := repo.db.Where("locked_until > ?", time.Now())
+	if attemptType != "" {
+		query = query.Where("type = ?", attemptType)
+	}
+
+	// pagination, latest lock first
+	if err := applyPage(query, "locked_until", "id", true, pagination).Find(&datas).Error; err != nil {
+		return nil, err
+	}
+	return datas, nil
+}
@@ -0,0 +1,60 @@
+package repositories
+
+import (
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type LoginEventRepository struct {
+	db *gorm.DB
+}
+
+func NewLoginEventRepository() *LoginEventRepository {
+	return &LoginEventRepository{db: models.DB}
+}
+
+func (repo *LoginEventRepository) Create(username, ipAddress, event string) error {
+	newData := models.LoginEvent{
+		Username:  username,
+		IPAddress: ipAddress,
+		Event:     event,
+	}
+	if err := repo.db.Create(&newData).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *LoginEventRepository) Count(username, event string) (int, error) {
+	var count int64
+	query := repo.db.Model(&models.LoginEvent{})
+	if username != "" {
+		query = query.Where("username = ?", username)
+	}
+	if event != "" {
+		query = query.Where("event = ?", event)
+	}
+	if err := query.Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func (repo *LoginEventRepository) ReadFilteredPaginated(username, event string, pagination helper.Pagination) ([]models.LoginEvent, error) {
+	var datas []models.LoginEvent
+
+	query := repo.db
+	if username != "" {
+		query = query.Where("username = ?", username)
+	}
+	if event != "" {
+		query = query.Where("event = ?", event)
+	}
+
+	// pagination, latest event first
+	if err := applyPage(query, "created_at", "id", true, pagination).Find(&datas).Error; err != nil {
+		return nil, err
+	}
+	return datas, nil
+}
@@ -1,25 +1,29 @@
 package repositories
 
 import (
+	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type ProjectPlatformRepository struct{}
+type ProjectPlatformRepository struct {
+	db *gorm.DB
+}
 
 func NewProjectPlatformRepository() *ProjectPlatformRepository {
-	return &ProjectPlatformRepository{}
+	return &ProjectPlatformRepository{db: models.DB}
 }
 
 func (repo *ProjectPlatformRepository) Create(newData *models.ProjectPlatform) (*models.ProjectPlatform, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
 }
 
-func (repo *ProjectPlatformRepository) Count() (int, error) {
+func (repo *ProjectPlatformRepository) Count(listQuery helper.ListQuery) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.ProjectPlatform{})
+	query := applyListFilter(repo.db.Model(&models.ProjectPlatform{}), listQuery, masterDataSearchColumns)
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
@@ -28,30 +32,19 @@ func (repo *ProjectPlatformRepository) Count() (int, error) {
 
 func (repo *ProjectPlatformRepository) ReadAll() ([]models.ProjectPlatform, error) {
 	var datas []models.ProjectPlatform
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
 }
 
-func (repo *ProjectPlatformRepository) ReadFilteredPaginated(pageSize, pageNumber int) ([]models.ProjectPlatform, error) {
+func (repo *ProjectPlatformRepository) ReadFilteredPaginated(listQuery helper.ListQuery) ([]models.ProjectPlatform, error) {
 	var datas []models.ProjectPlatform
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB
+	query := applyListFilter(repo.db, listQuery, masterDataSearchColumns)
+	query = applyPage(query, listQuery.Sort, "id", listQuery.Order == helper.SortOrderDesc, listQuery.Pagination)
 
-	// pagination
-	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
+	if err := query.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -59,7 +52,7 @@ func (repo *ProjectPlatformRepository) ReadFilteredPaginated(pageSize, pageNumbe
 
 func (repo *ProjectPlatformRepository) Read(ID int64) (*models.ProjectPlatform, error) {
 	var data models.ProjectPlatform
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -67,7 +60,7 @@ func (repo *ProjectPlatformRepository) Read(ID int64) (*models.ProjectPlatform,
 
 func (repo *ProjectPlatformRepository) ReadByCode(code string) (*models.ProjectPlatform, error) {
 	var data models.ProjectPlatform
-	if err := models.DB.Where("code = ?", code).First(&data).Error; err != nil {
+	if err := repo.db.Where("code = ?", code).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -75,16 +68,40 @@ func (repo *ProjectPlatformRepository) ReadByCode(code string) (*models.ProjectP
 
 func (repo *ProjectPlatformRepository) ReadByName(name string) (*models.ProjectPlatform, error) {
 	var data models.ProjectPlatform
-	if err := models.DB.Where("name = ?", name).First(&data).Error; err != nil {
+	if err := repo.db.Where("name = ?", name).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
-func (repo *ProjectPlatformRepository) ReadByNameOrCode(name string, code string) (*models.ProjectPlatform, error) {
-	var data models.ProjectPlatform
-	if err := models.DB.Where("name = ? OR code = ?", name, code).First(&data).Error; err != nil {
+// check name or code already used by other row, exceptID 0 check every row
+func (repo *ProjectPlatformRepository) ExistsByNameOrCode(name string, code string, exceptID int64) (bool, error) {
+	var count int64
+	if err := repo.db.Model(&models.ProjectPlatform{}).Where("(name = ? OR code = ?) AND id <> ?", name, code, exceptID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *ProjectPlatformRepository) Update(updatedData *models.ProjectPlatform) (*models.ProjectPlatform, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
 		return nil, err
 	}
-	return &data, nil
+	return updatedData, nil
+}
+
+func (repo *ProjectPlatformRepository) Delete(ID int64) error {
+	if err := repo.db.Delete(&models.ProjectPlatform{}, ID).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// count user owned rows that still reference the project platform
+func (repo *ProjectPlatformRepository) CountReferences(ID int64) (int, error) {
+	var count int64
+	if err := repo.db.Table("user_projects").Where("project_platform_id = ?", ID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
 }
@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type ProjectPlatformTranslationRepository struct{}
+type ProjectPlatformTranslationRepository struct {
+	db *gorm.DB
+}
 
 func NewProjectPlatformTranslationRepository() *ProjectPlatformTranslationRepository {
-	return &ProjectPlatformTranslationRepository{}
+	return &ProjectPlatformTranslationRepository{db: models.DB}
 }
 
 func (repo *ProjectPlatformTranslationRepository) Create(newData *models.ProjectPlatformTranslation) (*models.ProjectPlatformTranslation, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *ProjectPlatformTranslationRepository) Create(newData *models.Project
 
 func (repo *ProjectPlatformTranslationRepository) Count(languageID *int64) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.ProjectPlatformTranslation{})
+	query := repo.db.Model(&models.ProjectPlatformTranslation{})
 
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
@@ -33,7 +36,7 @@ func (repo *ProjectPlatformTranslationRepository) Count(languageID *int64) (int,
 }
 
 func (repo *ProjectPlatformTranslationRepository) ReadAll(languageID *int64) ([]models.ProjectPlatformTranslation, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.ProjectPlatformTranslation
 
 	if languageID != nil {
@@ -60,7 +63,7 @@ func (repo *ProjectPlatformTranslationRepository) ReadFilteredPaginated(language
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
 	}
@@ -74,7 +77,7 @@ func (repo *ProjectPlatformTranslationRepository) ReadFilteredPaginated(language
 
 func (repo *ProjectPlatformTranslationRepository) Read(ID int64) (*models.ProjectPlatformTranslation, error) {
 	var data models.ProjectPlatformTranslation
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -82,21 +85,28 @@ func (repo *ProjectPlatformTranslationRepository) Read(ID int64) (*models.Projec
 
 func (repo *ProjectPlatformTranslationRepository) ReadByLanguageIDProjectPlatformID(languageID int64, projectPlatformID int64) (*models.ProjectPlatformTranslation, error) {
 	var data models.ProjectPlatformTranslation
-	if err := models.DB.Where("language_id = ? AND project_platform_id = ?", languageID, projectPlatformID).First(&data).Error; err != nil {
+	if err := repo.db.Where("language_id = ? AND project_platform_id = ?", languageID, projectPlatformID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *ProjectPlatformTranslationRepository) Update(updatedData *models.ProjectPlatformTranslation) (*models.ProjectPlatformTranslation, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *ProjectPlatformTranslationRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.ProjectPlatformTranslation{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.ProjectPlatformTranslation{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *ProjectPlatformTranslationRepository) DeleteByLanguageIDProjectPlatformID(languageID int64, projectPlatformID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("language_id = ? AND project_platform_id = ?", languageID, projectPlatformID).
 		Delete(&models.ProjectPlatformTranslation{}).
 		Error; err != nil {
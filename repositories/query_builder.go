@@ -0,0 +1,95 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// sortable column of master data list (company, school, skill, language, project platform)
+var MasterDataSortColumns = []string{"id", "code", "name", "created_at", "updated_at"}
+
+// search column of master data list
+var masterDataSearchColumns = []string{"code", "name"}
+
+// q search by LIKE in any of the columns, created_at range
+func applyListFilter(query *gorm.DB, listQuery helper.ListQuery, searchColumns []string) *gorm.DB {
+	if listQuery.Search != "" && len(searchColumns) > 0 {
+		pattern := "%" + escapeLike(listQuery.Search) + "%"
+		conditions := make([]string, 0, len(searchColumns))
+		vars := make([]interface{}, 0, len(searchColumns))
+		for _, column := range searchColumns {
+			conditions = append(conditions, column+" LIKE ?")
+			vars = append(vars, pattern)
+		}
+		query = query.Where("("+strings.Join(conditions, " OR ")+")", vars...)
+	}
+
+	if listQuery.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", listQuery.CreatedFrom)
+	}
+	if listQuery.CreatedTo != nil {
+		query = query.Where("created_at < ?", listQuery.CreatedTo)
+	}
+	return query
+}
+
+// order by sort column then id as tie breaker, and page by offset or by cursor.
+// cursor page read one more row so the caller know whether there is another page,
+// previous page is read in reversed order and reversed back by helper.BuildCursorPage
+func applyPage(query *gorm.DB, sortColumn string, idColumn string, desc bool, pagination helper.Pagination) *gorm.DB {
+	if !pagination.CursorMode {
+		return applyPagination(applyOrder(query, sortColumn, idColumn, desc), pagination.Size, pagination.Number)
+	}
+
+	cursor := pagination.Cursor
+	scanDesc := desc
+	if cursor != nil && cursor.Backward {
+		scanDesc = !desc
+	}
+
+	if cursor != nil {
+		operator := ">"
+		if scanDesc {
+			operator = "<"
+		}
+		if sortColumn == idColumn {
+			query = query.Where(fmt.Sprintf("%s %s ?", idColumn, operator), cursor.ID)
+		} else {
+			value := helper.CursorValue(cursor)
+			query = query.Where(fmt.Sprintf("(%[1]s %[3]s ? OR (%[1]s = ? AND %[2]s %[3]s ?))", sortColumn, idColumn, operator), value, value, cursor.ID)
+		}
+	}
+
+	size := pagination.Size
+	if size <= 0 {
+		size = 5
+	}
+	return applyOrder(query, sortColumn, idColumn, scanDesc).Limit(size + 1)
+}
+
+func applyOrder(query *gorm.DB, sortColumn string, idColumn string, desc bool) *gorm.DB {
+	query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: sortColumn}, Desc: desc})
+	if sortColumn != idColumn {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: idColumn}, Desc: desc})
+	}
+	return query
+}
+
+// limit and offset from page size and page number, default 5 rows of first page
+func applyPagination(query *gorm.DB, pageSize, pageNumber int) *gorm.DB {
+	if pageSize <= 0 {
+		pageSize = 5
+	}
+	if pageNumber <= 0 {
+		pageNumber = 1
+	}
+	return query.Offset((pageNumber - 1) * pageSize).Limit(pageSize)
+}
+
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type RevokedTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewRevokedTokenRepository() *RevokedTokenRepository {
+	return &RevokedTokenRepository{db: models.DB}
+}
+
+func (repo *RevokedTokenRepository) Create(jti string, userID int64, expiresAt time.Time) error {
+	newData := models.RevokedToken{
+		JTI:       jti,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	}
+	if err := repo.db.Where(models.RevokedToken{JTI: jti}).FirstOrCreate(&newData).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *RevokedTokenRepository) IsRevoked(jti string) (bool, error) {
+	var count int64
+	if err := repo.db.Model(&models.RevokedToken{}).Where("jti = ?", jti).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// expired access token already rejected by jwt parser, no need to keep it
+func (repo *RevokedTokenRepository) DeleteExpired() error {
+	if err := repo.db.Where("expires_at < ?", time.Now()).Delete(&models.RevokedToken{}).Error; err != nil {
+		return err
+	}
+	return nil
+}
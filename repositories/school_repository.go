@@ -1,25 +1,29 @@
 package repositories
 
 import (
+	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type SchoolRepository struct{}
+type SchoolRepository struct {
+	db *gorm.DB
+}
 
 func NewSchoolRepository() *SchoolRepository {
-	return &SchoolRepository{}
+	return &SchoolRepository{db: models.DB}
 }
 
 func (repo *SchoolRepository) Create(newData *models.School) (*models.School, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
 }
 
-func (repo *SchoolRepository) Count() (int, error) {
+func (repo *SchoolRepository) Count(listQuery helper.ListQuery) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.School{})
+	query := applyListFilter(repo.db.Model(&models.School{}), listQuery, masterDataSearchColumns)
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
@@ -28,30 +32,19 @@ func (repo *SchoolRepository) Count() (int, error) {
 
 func (repo *SchoolRepository) ReadAll() ([]models.School, error) {
 	var datas []models.School
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
 }
 
-func (repo *SchoolRepository) ReadFilteredPaginated(pageSize, pageNumber int) ([]models.School, error) {
+func (repo *SchoolRepository) ReadFilteredPaginated(listQuery helper.ListQuery) ([]models.School, error) {
 	var datas []models.School
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB
+	query := applyListFilter(repo.db, listQuery, masterDataSearchColumns)
+	query = applyPage(query, listQuery.Sort, "id", listQuery.Order == helper.SortOrderDesc, listQuery.Pagination)
 
-	// pagination
-	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
+	if err := query.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -59,7 +52,7 @@ func (repo *SchoolRepository) ReadFilteredPaginated(pageSize, pageNumber int) ([
 
 func (repo *SchoolRepository) Read(ID int64) (*models.School, error) {
 	var data models.School
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -67,7 +60,7 @@ func (repo *SchoolRepository) Read(ID int64) (*models.School, error) {
 
 func (repo *SchoolRepository) ReadByCode(code string) (*models.School, error) {
 	var data models.School
-	if err := models.DB.Where("code = ?", code).First(&data).Error; err != nil {
+	if err := repo.db.Where("code = ?", code).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -75,16 +68,40 @@ func (repo *SchoolRepository) ReadByCode(code string) (*models.School, error) {
 
 func (repo *SchoolRepository) ReadByName(name string) (*models.School, error) {
 	var data models.School
-	if err := models.DB.Where("name = ?", name).First(&data).Error; err != nil {
+	if err := repo.db.Where("name = ?", name).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
-func (repo *SchoolRepository) ReadByNameOrCode(name string, code string) (*models.School, error) {
-	var data models.School
-	if err := models.DB.Where("name = ? OR code = ?", name, code).First(&data).Error; err != nil {
+// check name or code already used by other row, exceptID 0 check every row
+func (repo *SchoolRepository) ExistsByNameOrCode(name string, code string, exceptID int64) (bool, error) {
+	var count int64
+	if err := repo.db.Model(&models.School{}).Where("(name = ? OR code = ?) AND id <> ?", name, code, exceptID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *SchoolRepository) Update(updatedData *models.School) (*models.School, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
 		return nil, err
 	}
-	return &data, nil
+	return updatedData, nil
+}
+
+func (repo *SchoolRepository) Delete(ID int64) error {
+	if err := repo.db.Delete(&models.School{}, ID).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// count user owned rows that still reference the school
+func (repo *SchoolRepository) CountReferences(ID int64) (int, error) {
+	var count int64
+	if err := repo.db.Table("user_educations").Where("school_id = ?", ID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
 }
@@ -1,25 +1,29 @@
 package repositories
 
 import (
+	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type SkillRepository struct{}
+type SkillRepository struct {
+	db *gorm.DB
+}
 
 func NewSkillRepository() *SkillRepository {
-	return &SkillRepository{}
+	return &SkillRepository{db: models.DB}
 }
 
 func (repo *SkillRepository) Create(newData *models.Skill) (*models.Skill, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
 }
 
-func (repo *SkillRepository) Count() (int, error) {
+func (repo *SkillRepository) Count(listQuery helper.ListQuery) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.Skill{})
+	query := applyListFilter(repo.db.Model(&models.Skill{}), listQuery, masterDataSearchColumns)
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
@@ -28,30 +32,19 @@ func (repo *SkillRepository) Count() (int, error) {
 
 func (repo *SkillRepository) ReadAll() ([]models.Skill, error) {
 	var datas []models.Skill
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
 }
 
-func (repo *SkillRepository) ReadFilteredPaginated(pageSize, pageNumber int) ([]models.Skill, error) {
+func (repo *SkillRepository) ReadFilteredPaginated(listQuery helper.ListQuery) ([]models.Skill, error) {
 	var datas []models.Skill
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB
+	query := applyListFilter(repo.db, listQuery, masterDataSearchColumns)
+	query = applyPage(query, listQuery.Sort, "id", listQuery.Order == helper.SortOrderDesc, listQuery.Pagination)
 
-	// pagination
-	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
+	if err := query.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -59,7 +52,7 @@ func (repo *SkillRepository) ReadFilteredPaginated(pageSize, pageNumber int) ([]
 
 func (repo *SkillRepository) Read(ID int64) (*models.Skill, error) {
 	var data models.Skill
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -67,7 +60,7 @@ func (repo *SkillRepository) Read(ID int64) (*models.Skill, error) {
 
 func (repo *SkillRepository) ReadByCode(code string) (*models.Skill, error) {
 	var data models.Skill
-	if err := models.DB.Where("code = ?", code).First(&data).Error; err != nil {
+	if err := repo.db.Where("code = ?", code).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -75,16 +68,40 @@ func (repo *SkillRepository) ReadByCode(code string) (*models.Skill, error) {
 
 func (repo *SkillRepository) ReadByName(name string) (*models.Skill, error) {
 	var data models.Skill
-	if err := models.DB.Where("name = ?", name).First(&data).Error; err != nil {
+	if err := repo.db.Where("name = ?", name).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
-func (repo *SkillRepository) ReadByNameOrCode(name string, code string) (*models.Skill, error) {
-	var data models.Skill
-	if err := models.DB.Where("name = ? OR code = ?", name, code).First(&data).Error; err != nil {
+// check name or code already used by other row, exceptID 0 check every row
+func (repo *SkillRepository) ExistsByNameOrCode(name string, code string, exceptID int64) (bool, error) {
+	var count int64
+	if err := repo.db.Model(&models.Skill{}).Where("(name = ? OR code = ?) AND id <> ?", name, code, exceptID).Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (repo *SkillRepository) Update(updatedData *models.Skill) (*models.Skill, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
 		return nil, err
 	}
-	return &data, nil
+	return updatedData, nil
+}
+
+func (repo *SkillRepository) Delete(ID int64) error {
+	if err := repo.db.Delete(&models.Skill{}, ID).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// count user owned rows that still reference the skill
+func (repo *SkillRepository) CountReferences(ID int64) (int, error) {
+	var count int64
+	if err := repo.db.Table("user_skills").Where("skill_id = ?", ID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
 }
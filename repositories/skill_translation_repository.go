@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type SkillTranslationRepository struct{}
+type SkillTranslationRepository struct {
+	db *gorm.DB
+}
 
 func NewSkillTranslationRepository() *SkillTranslationRepository {
-	return &SkillTranslationRepository{}
+	return &SkillTranslationRepository{db: models.DB}
 }
 
 func (repo *SkillTranslationRepository) Create(newData *models.SkillTranslation) (*models.SkillTranslation, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *SkillTranslationRepository) Create(newData *models.SkillTranslation)
 
 func (repo *SkillTranslationRepository) Count(languageID *int64) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.SkillTranslation{})
+	query := repo.db.Model(&models.SkillTranslation{})
 
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
@@ -33,7 +36,7 @@ func (repo *SkillTranslationRepository) Count(languageID *int64) (int, error) {
 }
 
 func (repo *SkillTranslationRepository) ReadAll(languageID *int64) ([]models.SkillTranslation, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.SkillTranslation
 
 	if languageID != nil {
@@ -60,7 +63,7 @@ func (repo *SkillTranslationRepository) ReadFilteredPaginated(languageID *int64,
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
 	}
@@ -74,7 +77,7 @@ func (repo *SkillTranslationRepository) ReadFilteredPaginated(languageID *int64,
 
 func (repo *SkillTranslationRepository) Read(ID int64) (*models.SkillTranslation, error) {
 	var data models.SkillTranslation
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -82,21 +85,28 @@ func (repo *SkillTranslationRepository) Read(ID int64) (*models.SkillTranslation
 
 func (repo *SkillTranslationRepository) ReadByLanguageIDSkillID(languageID int64, skillID int64) (*models.SkillTranslation, error) {
 	var data models.SkillTranslation
-	if err := models.DB.Where("language_id = ? AND skill_id = ?", languageID, skillID).First(&data).Error; err != nil {
+	if err := repo.db.Where("language_id = ? AND skill_id = ?", languageID, skillID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *SkillTranslationRepository) Update(updatedData *models.SkillTranslation) (*models.SkillTranslation, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *SkillTranslationRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.SkillTranslation{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.SkillTranslation{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *SkillTranslationRepository) DeleteByLanguageIDSkillID(languageID int64, skillID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("language_id = ? AND skill_id = ?", languageID, skillID).
 		Delete(&models.SkillTranslation{}).
 		Error; err != nil {
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type StorageReferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewStorageReferenceRepository() *StorageReferenceRepository {
+	return &StorageReferenceRepository{db: models.DB}
+}
+
+// every column holding path of uploaded image
+var storageReferenceColumns = []struct {
+	Table  string
+	Column string
+}{
+	{Table: "companies", Column: "image_url"},
+	{Table: "schools", Column: "image_url"},
+	{Table: "skills", Column: "image_url"},
+	{Table: "project_platforms", Column: "image_url"},
+	{Table: "languages", Column: "logo_url"},
+	{Table: "user_attachments", Column: "image_url"},
+	{Table: "user_projects", Column: "image_url"},
+	{Table: "user_project_attachments", Column: "image_url"},
+}
+
+// every row with non empty image column
+func (repo *StorageReferenceRepository) ReadAll() ([]models.StorageReference, error) {
+	var references []models.StorageReference
+	for _, referenceColumn := range storageReferenceColumns {
+		var datas []models.StorageReference
+		if err := repo.db.Table(referenceColumn.Table).
+			Select("id, " + referenceColumn.Column + " AS path").
+			Where(referenceColumn.Column + " <> ''").
+			Scan(&datas).Error; err != nil {
+			return nil, err
+		}
+
+		for i := range datas {
+			datas[i].Table = referenceColumn.Table
+			datas[i].Column = referenceColumn.Column
+		}
+		references = append(references, datas...)
+	}
+	return references, nil
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// keep one translation of every item, the first language of languageIDs which has translation win
+func wherePreferredTranslation(query *gorm.DB, table string, itemColumn string, languageIDs []int64) *gorm.DB {
+	if len(languageIDs) == 0 {
+		return query.Where("1 = 0")
+	}
+
+	// gorm wrap slice in parentheses, FIELD need one placeholder for every language
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(languageIDs)), ",")
+	var vars []interface{}
+	for i := 0; i < 3; i++ {
+		for _, languageID := range languageIDs {
+			vars = append(vars, languageID)
+		}
+	}
+
+	return query.
+		Where(table+".language_id IN ?", languageIDs).
+		Where(fmt.Sprintf(`NOT EXISTS (
+			SELECT 1 FROM %[1]s AS preferred
+			WHERE preferred.%[2]s = %[1]s.%[2]s
+			AND preferred.language_id IN (%[3]s)
+			AND FIELD(preferred.language_id, %[3]s) < FIELD(%[1]s.language_id, %[3]s)
+		)`, table, itemColumn, placeholders), vars...)
+}
+
+// order rows by position of column value in languageIDs
+func orderByLanguageChain(column string, languageIDs []int64) clause.Expression {
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(languageIDs)), ",")
+	vars := make([]interface{}, 0, len(languageIDs))
+	for _, languageID := range languageIDs {
+		vars = append(vars, languageID)
+	}
+	return clause.OrderBy{
+		Expression: clause.Expr{SQL: fmt.Sprintf("FIELD(%s, %s)", column, placeholders), Vars: vars, WithoutParentheses: true},
+	}
+}
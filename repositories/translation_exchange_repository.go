@@ -0,0 +1,152 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type translationExchangeSection struct {
+	// item id owned by user, take user id as parameter
+	ownedItemQuery    string
+	translationTable  string
+	translationColumn string
+	// not translatable column copied from source translation when target translation is created
+	copyColumns []string
+}
+
+// skill translation belong to master skill, so skill item is the skill used by user
+var translationExchangeSections = map[string]translationExchangeSection{
+	models.TranslationSectionExperience: {
+		ownedItemQuery:    "SELECT id FROM user_experiences WHERE user_id = ?",
+		translationTable:  "user_experience_translations",
+		translationColumn: "user_experience_id",
+		copyColumns:       []string{"category", "location", "location_type"},
+	},
+	models.TranslationSectionEducation: {
+		ownedItemQuery:    "SELECT id FROM user_educations WHERE user_id = ?",
+		translationTable:  "user_education_translations",
+		translationColumn: "user_education_id",
+		copyColumns:       []string{"category", "location", "location_type"},
+	},
+	models.TranslationSectionProject: {
+		ownedItemQuery:    "SELECT id FROM user_projects WHERE user_id = ?",
+		translationTable:  "user_project_translations",
+		translationColumn: "user_project_id",
+	},
+	models.TranslationSectionLanguage: {
+		ownedItemQuery:    "SELECT id FROM user_languages WHERE user_id = ?",
+		translationTable:  "user_language_translations",
+		translationColumn: "user_language_id",
+	},
+	models.TranslationSectionSkill: {
+		ownedItemQuery:    "SELECT skill_id FROM user_skills WHERE user_id = ?",
+		translationTable:  "skill_translations",
+		translationColumn: "skill_id",
+	},
+}
+
+type TranslationExchangeRepository struct {
+	db *gorm.DB
+}
+
+func NewTranslationExchangeRepository() *TranslationExchangeRepository {
+	return &TranslationExchangeRepository{db: models.DB}
+}
+
+// values of one translatable field for items owned by user in the given languages
+func (repo *TranslationExchangeRepository) ReadValues(section string, field string, userID int64, languageIDs []int64) ([]models.TranslationExchangeValue, error) {
+	var datas []models.TranslationExchangeValue
+	spec := translationExchangeSections[section]
+
+	if err := repo.db.
+		Table(spec.translationTable).
+		Select(fmt.Sprintf("%s AS item_id, language_id, %s AS value", spec.translationColumn, field)).
+		Where(spec.translationColumn+" IN ("+spec.ownedItemQuery+")", userID).
+		Where("language_id IN ?", languageIDs).
+		Order(spec.translationColumn).
+		Find(&datas).Error; err != nil {
+		return nil, err
+	}
+	return datas, nil
+}
+
+// update existing target translation or create it from source translation, all or nothing.
+// created translation take not given field from source translation
+func (repo *TranslationExchangeRepository) Upsert(sourceLanguageID, targetLanguageID int64, updates []models.TranslationExchangeUpdate) (created int, updated int, err error) {
+	err = repo.db.Transaction(func(tx *gorm.DB) error {
+		created, updated = 0, 0
+		for _, update := range updates {
+			spec := translationExchangeSections[update.Section]
+
+			values := map[string]interface{}{"updated_at": time.Now()}
+			for field, value := range update.Values {
+				values[field] = value
+			}
+			// imported by translator, draft become approved translation
+			if update.Section == models.TranslationSectionProject {
+				values["machine_translated"] = false
+			}
+
+			var count int64
+			if err := tx.Table(spec.translationTable).
+				Where(spec.translationColumn+" = ? AND language_id = ?", update.ItemID, targetLanguageID).
+				Count(&count).Error; err != nil {
+				return err
+			}
+
+			if count > 0 {
+				if err := tx.Table(spec.translationTable).
+					Where(spec.translationColumn+" = ? AND language_id = ?", update.ItemID, targetLanguageID).
+					Updates(values).Error; err != nil {
+					return err
+				}
+				updated++
+				continue
+			}
+
+			if err := createTranslationFromSource(tx, spec, sourceLanguageID, targetLanguageID, update); err != nil {
+				return err
+			}
+			created++
+		}
+		return nil
+	})
+	return created, updated, err
+}
+
+func createTranslationFromSource(tx *gorm.DB, spec translationExchangeSection, sourceLanguageID, targetLanguageID int64, update models.TranslationExchangeUpdate) error {
+	columns := []string{spec.translationColumn, "language_id"}
+	selects := []string{spec.translationColumn, "?"}
+	args := []interface{}{targetLanguageID}
+
+	for _, field := range models.TranslationExchangeFields[update.Section] {
+		columns = append(columns, field)
+		if value, ok := update.Values[field]; ok {
+			selects = append(selects, "?")
+			args = append(args, value)
+		} else {
+			selects = append(selects, field)
+		}
+	}
+	for _, column := range spec.copyColumns {
+		columns = append(columns, column)
+		selects = append(selects, column)
+	}
+	args = append(args, update.ItemID, sourceLanguageID)
+
+	result := tx.Exec(fmt.Sprintf(
+		"INSERT INTO %s (%s, created_at, updated_at) SELECT %s, NOW(), NOW() FROM %s WHERE %s = ? AND language_id = ?",
+		spec.translationTable, strings.Join(columns, ", "), strings.Join(selects, ", "), spec.translationTable, spec.translationColumn,
+	), args...)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("source translation of %s %d not found", update.Section, update.ItemID)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type translationStatusSection struct {
+	itemTable         string
+	itemJoin          string
+	itemSelect        string
+	translationTable  string
+	translationColumn string
+}
+
+// skill translation belong to master skill, so skill item is the skill used by user
+var translationStatusSections = map[string]translationStatusSection{
+	models.TranslationSectionExperience: {
+		itemTable:         "user_experiences",
+		itemJoin:          "LEFT JOIN companies ON user_experiences.company_id = companies.id",
+		itemSelect:        "user_experiences.id AS id, CONCAT(IFNULL(companies.name, ''), ' (', user_experiences.year_start, ')') AS label",
+		translationTable:  "user_experience_translations",
+		translationColumn: "user_experience_id",
+	},
+	models.TranslationSectionEducation: {
+		itemTable:         "user_educations",
+		itemJoin:          "LEFT JOIN schools ON user_educations.school_id = schools.id",
+		itemSelect:        "user_educations.id AS id, CONCAT(IFNULL(schools.name, ''), ' (', user_educations.year_start, ')') AS label",
+		translationTable:  "user_education_translations",
+		translationColumn: "user_education_id",
+	},
+	models.TranslationSectionProject: {
+		itemTable:         "user_projects",
+		itemSelect:        "user_projects.id AS id, user_projects.slug AS label",
+		translationTable:  "user_project_translations",
+		translationColumn: "user_project_id",
+	},
+	models.TranslationSectionLanguage: {
+		itemTable:         "user_languages",
+		itemJoin:          "LEFT JOIN languages ON user_languages.language_id = languages.id",
+		itemSelect:        "user_languages.id AS id, IFNULL(languages.name, '') AS label",
+		translationTable:  "user_language_translations",
+		translationColumn: "user_language_id",
+	},
+	models.TranslationSectionSkill: {
+		itemTable:         "user_skills",
+		itemJoin:          "JOIN skills ON user_skills.skill_id = skills.id",
+		itemSelect:        "DISTINCT skills.id AS id, skills.name AS label",
+		translationTable:  "skill_translations",
+		translationColumn: "skill_id",
+	},
+}
+
+type TranslationStatusRepository struct {
+	db *gorm.DB
+}
+
+func NewTranslationStatusRepository() *TranslationStatusRepository {
+	return &TranslationStatusRepository{db: models.DB}
+}
+
+// translatable rows of user in section ordered by id
+func (repo *TranslationStatusRepository) ReadItems(section string, userID int64) ([]models.TranslationStatusItem, error) {
+	var datas []models.TranslationStatusItem
+	spec := translationStatusSections[section]
+
+	query := repo.db.Table(spec.itemTable).Select(spec.itemSelect)
+	if spec.itemJoin != "" {
+		query = query.Joins(spec.itemJoin)
+	}
+
+	if err := query.Where(spec.itemTable+".user_id = ?", userID).Order("id").Find(&datas).Error; err != nil {
+		return nil, err
+	}
+	return datas, nil
+}
+
+// existing translations of the given rows in section
+func (repo *TranslationStatusRepository) ReadTranslations(section string, itemIDs []int64) ([]models.TranslationStatusPair, error) {
+	var datas []models.TranslationStatusPair
+	if len(itemIDs) == 0 {
+		return datas, nil
+	}
+	spec := translationStatusSections[section]
+
+	if err := repo.db.
+		Table(spec.translationTable).
+		Select(spec.translationColumn+" AS item_id, language_id").
+		Where(spec.translationColumn+" IN ?", itemIDs).
+		Find(&datas).Error; err != nil {
+		return nil, err
+	}
+	return datas, nil
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"log"
+
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"github.com/FRanggaY/personal-portfolio-api/storages"
+	"gorm.io/gorm"
+)
+
+// database transaction with staged file operations, repositories from the unit of work run inside the transaction.
+// defer Rollback right after creating it, rollback after commit does nothing
+type UnitOfWork struct {
+	// begun on first repository, upload before it does not hold a connection
+	tx    *gorm.DB
+	Files *storages.FileTransaction
+	done  bool
+}
+
+func NewUnitOfWork(storage storages.Storage) *UnitOfWork {
+	return &UnitOfWork{
+		Files: storages.NewFileTransaction(storage),
+	}
+}
+
+func (uow *UnitOfWork) db() *gorm.DB {
+	if uow.tx == nil {
+		uow.tx = models.DB.Begin()
+	}
+	return uow.tx
+}
+
+// commit database first, staged file delete only run after the rows no longer point to the files
+func (uow *UnitOfWork) Commit() error {
+	if uow.done {
+		return nil
+	}
+	uow.done = true
+
+	if uow.tx != nil {
+		if err := uow.tx.Commit().Error; err != nil {
+			if errRollback := uow.Files.Rollback(); errRollback != nil {
+				log.Println("Failed to roll back files: ", errRollback)
+			}
+			return err
+		}
+	}
+
+	// database is already committed, file left behind is cleaned by storage consistency check
+	if err := uow.Files.Commit(); err != nil {
+		log.Println("Failed to commit file operations: ", err)
+	}
+	return nil
+}
+
+func (uow *UnitOfWork) Rollback() {
+	if uow.done {
+		return
+	}
+	uow.done = true
+
+	if uow.tx != nil {
+		uow.tx.Rollback()
+	}
+	if err := uow.Files.Rollback(); err != nil {
+		log.Println("Failed to roll back files: ", err)
+	}
+}
+
+func (uow *UnitOfWork) CompanyRepository() *CompanyRepository {
+	return &CompanyRepository{db: uow.db()}
+}
+
+func (uow *UnitOfWork) SchoolRepository() *SchoolRepository {
+	return &SchoolRepository{db: uow.db()}
+}
+
+func (uow *UnitOfWork) SkillRepository() *SkillRepository {
+	return &SkillRepository{db: uow.db()}
+}
+
+func (uow *UnitOfWork) ProjectPlatformRepository() *ProjectPlatformRepository {
+	return &ProjectPlatformRepository{db: uow.db()}
+}
+
+func (uow *UnitOfWork) LanguageRepository() *LanguageRepository {
+	return &LanguageRepository{db: uow.db()}
+}
+
+func (uow *UnitOfWork) UserAttachmentRepository() *UserAttachmentRepository {
+	return &UserAttachmentRepository{db: uow.db()}
+}
+
+func (uow *UnitOfWork) UserProjectRepository() *UserProjectRepository {
+	return &UserProjectRepository{db: uow.db()}
+}
+
+func (uow *UnitOfWork) UserProjectAttachmentRepository() *UserProjectAttachmentRepository {
+	return &UserProjectAttachmentRepository{db: uow.db()}
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/helper"
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type UserAPIKeyRepository struct {
+	db *gorm.DB
+}
+
+func NewUserAPIKeyRepository() *UserAPIKeyRepository {
+	return &UserAPIKeyRepository{db: models.DB}
+}
+
+func (repo *UserAPIKeyRepository) Create(newData *models.UserAPIKey) (*models.UserAPIKey, error) {
+	if err := repo.db.Create(newData).Error; err != nil {
+		return nil, err
+	}
+	return newData, nil
+}
+
+func (repo *UserAPIKeyRepository) ReadAll(userID int64) ([]models.UserAPIKey, error) {
+	var datas []models.UserAPIKey
+	if err := repo.db.Where(helper.FilterUserIDEqual, userID).Order("created_at desc").Find(&datas).Error; err != nil {
+		return nil, err
+	}
+	return datas, nil
+}
+
+func (repo *UserAPIKeyRepository) Read(ID int64) (*models.UserAPIKey, error) {
+	var data models.UserAPIKey
+	if err := repo.db.First(&data, ID).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// only active key is returned
+func (repo *UserAPIKeyRepository) ReadActiveByKeyHash(keyHash string) (*models.UserAPIKey, error) {
+	var data models.UserAPIKey
+	if err := repo.db.Where("key_hash = ? AND revoked_at IS NULL", keyHash).First(&data).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// last used is saved at most once a minute to avoid write on every request
+func (repo *UserAPIKeyRepository) TouchLastUsed(ID int64) error {
+	now := time.Now()
+	if err := repo.db.Model(&models.UserAPIKey{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", ID, now.Add(-time.Minute)).
+		Update("last_used_at", now).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+func (repo *UserAPIKeyRepository) Revoke(ID int64) error {
+	if err := repo.db.Model(&models.UserAPIKey{}).
+		Where("id = ? AND revoked_at IS NULL", ID).
+		Update("revoked_at", time.Now()).Error; err != nil {
+		return err
+	}
+	return nil
+}
@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserAttachmentRepository struct{}
+type UserAttachmentRepository struct {
+	db *gorm.DB
+}
 
 func NewUserAttachmentRepository() *UserAttachmentRepository {
-	return &UserAttachmentRepository{}
+	return &UserAttachmentRepository{db: models.DB}
 }
 
 func (repo *UserAttachmentRepository) Create(newData *models.UserAttachment) (*models.UserAttachment, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserAttachmentRepository) Create(newData *models.UserAttachment) (*m
 
 func (repo *UserAttachmentRepository) Count(userID *int64, category *string) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserAttachment{})
+	query := repo.db.Model(&models.UserAttachment{})
 
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
@@ -37,7 +40,7 @@ func (repo *UserAttachmentRepository) Count(userID *int64, category *string) (in
 }
 
 func (repo *UserAttachmentRepository) ReadAll(userID *int64, category *string, isActive *bool) ([]models.UserAttachment, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserAttachment
 
 	if userID != nil {
@@ -72,7 +75,7 @@ func (repo *UserAttachmentRepository) ReadFilteredPaginated(userID *int64, categ
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
 	}
@@ -90,14 +93,21 @@ func (repo *UserAttachmentRepository) ReadFilteredPaginated(userID *int64, categ
 
 func (repo *UserAttachmentRepository) Read(ID int64) (*models.UserAttachment, error) {
 	var data models.UserAttachment
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserAttachmentRepository) Update(updatedData *models.UserAttachment) (*models.UserAttachment, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserAttachmentRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserAttachment{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserAttachment{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserEducationRepository struct{}
+type UserEducationRepository struct {
+	db *gorm.DB
+}
 
 func NewUserEducationRepository() *UserEducationRepository {
-	return &UserEducationRepository{}
+	return &UserEducationRepository{db: models.DB}
 }
 
 func (repo *UserEducationRepository) Create(newData *models.UserEducation) (*models.UserEducation, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserEducationRepository) Create(newData *models.UserEducation) (*mod
 
 func (repo *UserEducationRepository) Count(userID *int64, isActive *bool) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserEducation{})
+	query := repo.db.Model(&models.UserEducation{})
 
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
@@ -37,7 +40,7 @@ func (repo *UserEducationRepository) Count(userID *int64, isActive *bool) (int,
 }
 
 func (repo *UserEducationRepository) ReadAll(userID *int64) ([]models.UserEducation, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserEducation
 
 	if userID != nil {
@@ -64,7 +67,7 @@ func (repo *UserEducationRepository) ReadFilteredPaginated(userID *int64, pageSi
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
 	}
@@ -78,7 +81,7 @@ func (repo *UserEducationRepository) ReadFilteredPaginated(userID *int64, pageSi
 
 func (repo *UserEducationRepository) Read(ID int64) (*models.UserEducation, error) {
 	var data models.UserEducation
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -86,21 +89,28 @@ func (repo *UserEducationRepository) Read(ID int64) (*models.UserEducation, erro
 
 func (repo *UserEducationRepository) ReadByUserIDSchoolID(userID int64, schoolID int64) (*models.UserEducation, error) {
 	var data models.UserEducation
-	if err := models.DB.Where("user_id = ? AND school_id = ?", userID, schoolID).First(&data).Error; err != nil {
+	if err := repo.db.Where("user_id = ? AND school_id = ?", userID, schoolID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserEducationRepository) Update(updatedData *models.UserEducation) (*models.UserEducation, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserEducationRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserEducation{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserEducation{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserEducationRepository) DeleteByUserIDSchoolID(userID int64, schoolID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("user_id = ? AND school_id = ?", userID, schoolID).
 		Delete(&models.UserEducation{}).
 		Error; err != nil {
@@ -109,21 +119,10 @@ func (repo *UserEducationRepository) DeleteByUserIDSchoolID(userID int64, school
 	return nil
 }
 
-func (repo *UserEducationRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageID int64, isActive *bool, pageNumber int, pageSize int) ([]models.EducationTranslationResponse, error) {
+func (repo *UserEducationRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageIDs []int64, isActive *bool, pagination helper.Pagination) ([]models.EducationTranslationResponse, error) {
 	var skills []models.EducationTranslationResponse
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB.
+	query := repo.db.
 		Table("user_education_translations").
 		Select(`
 			user_education_translations.*,
@@ -142,14 +141,17 @@ func (repo *UserEducationRepository) ReadTranslationsByUserIDLanguageID(userID i
         `).
 		Joins("LEFT JOIN user_educations ON user_education_translations.user_education_id = user_educations.id").
 		Joins("LEFT JOIN schools ON user_educations.school_id = schools.id").
-		Where("user_educations.user_id = ?", userID).
-		Where("user_education_translations.language_id = ?", languageID).
-		Limit(pageSize).Offset(offset)
+		Where("user_educations.user_id = ?", userID)
+
+	// requested language first, then fallback languages
+	query = wherePreferredTranslation(query, "user_education_translations", "user_education_id", languageIDs)
 
 	if isActive != nil {
 		query = query.Where("user_educations.is_active = ?", isActive)
 	}
 
+	query = applyPage(query, "user_education_translations.id", "user_education_translations.id", false, pagination)
+
 	if err := query.Find(&skills).Error; err != nil {
 		return nil, err
 	}
@@ -2,16 +2,19 @@ package repositories
 
 import (
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserEducationTranslationRepository struct{}
+type UserEducationTranslationRepository struct {
+	db *gorm.DB
+}
 
 func NewUserEducationTranslationRepository() *UserEducationTranslationRepository {
-	return &UserEducationTranslationRepository{}
+	return &UserEducationTranslationRepository{db: models.DB}
 }
 
 func (repo *UserEducationTranslationRepository) Create(newData *models.UserEducationTranslation) (*models.UserEducationTranslation, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -19,7 +22,7 @@ func (repo *UserEducationTranslationRepository) Create(newData *models.UserEduca
 
 func (repo *UserEducationTranslationRepository) Count() (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserEducationTranslation{})
+	query := repo.db.Model(&models.UserEducationTranslation{})
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
@@ -28,7 +31,7 @@ func (repo *UserEducationTranslationRepository) Count() (int, error) {
 
 func (repo *UserEducationTranslationRepository) ReadAll() ([]models.UserEducationTranslation, error) {
 	var datas []models.UserEducationTranslation
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -48,7 +51,7 @@ func (repo *UserEducationTranslationRepository) ReadFilteredPaginated(pageSize,
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 
 	// pagination
 	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
@@ -59,7 +62,7 @@ func (repo *UserEducationTranslationRepository) ReadFilteredPaginated(pageSize,
 
 func (repo *UserEducationTranslationRepository) Read(ID int64) (*models.UserEducationTranslation, error) {
 	var data models.UserEducationTranslation
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -67,21 +70,28 @@ func (repo *UserEducationTranslationRepository) Read(ID int64) (*models.UserEduc
 
 func (repo *UserEducationTranslationRepository) ReadByLanguageIDUserEducationID(languageID int64, userEducationID int64) (*models.UserEducationTranslation, error) {
 	var data models.UserEducationTranslation
-	if err := models.DB.Where("language_id = ? AND user_education_id = ?", languageID, userEducationID).First(&data).Error; err != nil {
+	if err := repo.db.Where("language_id = ? AND user_education_id = ?", languageID, userEducationID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserEducationTranslationRepository) Update(updatedData *models.UserEducationTranslation) (*models.UserEducationTranslation, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserEducationTranslationRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserEducationTranslation{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserEducationTranslation{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserEducationTranslationRepository) DeleteByLanguageIDUserEducationID(languageID int64, userEducationID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("language_id = ? AND user_education_id = ?", languageID, userEducationID).
 		Delete(&models.UserEducationTranslation{}).
 		Error; err != nil {
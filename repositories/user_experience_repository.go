@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserExperienceRepository struct{}
+type UserExperienceRepository struct {
+	db *gorm.DB
+}
 
 func NewUserExperienceRepository() *UserExperienceRepository {
-	return &UserExperienceRepository{}
+	return &UserExperienceRepository{db: models.DB}
 }
 
 func (repo *UserExperienceRepository) Create(newData *models.UserExperience) (*models.UserExperience, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserExperienceRepository) Create(newData *models.UserExperience) (*m
 
 func (repo *UserExperienceRepository) Count(userID *int64, isActive *bool) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserExperience{})
+	query := repo.db.Model(&models.UserExperience{})
 
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
@@ -37,7 +40,7 @@ func (repo *UserExperienceRepository) Count(userID *int64, isActive *bool) (int,
 }
 
 func (repo *UserExperienceRepository) ReadAll(userID *int64) ([]models.UserExperience, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserExperience
 
 	if userID != nil {
@@ -64,7 +67,7 @@ func (repo *UserExperienceRepository) ReadFilteredPaginated(userID *int64, pageS
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
 	}
@@ -78,7 +81,7 @@ func (repo *UserExperienceRepository) ReadFilteredPaginated(userID *int64, pageS
 
 func (repo *UserExperienceRepository) Read(ID int64) (*models.UserExperience, error) {
 	var data models.UserExperience
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -86,21 +89,28 @@ func (repo *UserExperienceRepository) Read(ID int64) (*models.UserExperience, er
 
 func (repo *UserExperienceRepository) ReadByUserIDCompanyID(userID int64, companyID int64) (*models.UserExperience, error) {
 	var data models.UserExperience
-	if err := models.DB.Where("user_id = ? AND company_id = ?", userID, companyID).First(&data).Error; err != nil {
+	if err := repo.db.Where("user_id = ? AND company_id = ?", userID, companyID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserExperienceRepository) Update(updatedData *models.UserExperience) (*models.UserExperience, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserExperienceRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserExperience{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserExperience{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserExperienceRepository) DeleteByUserIDCompanyID(userID int64, companyID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("user_id = ? AND company_id = ?", userID, companyID).
 		Delete(&models.UserExperience{}).
 		Error; err != nil {
@@ -109,21 +119,10 @@ func (repo *UserExperienceRepository) DeleteByUserIDCompanyID(userID int64, comp
 	return nil
 }
 
-func (repo *UserExperienceRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageID int64, isActive *bool, pageNumber int, pageSize int) ([]models.ExperienceTranslationResponse, error) {
+func (repo *UserExperienceRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageIDs []int64, isActive *bool, pagination helper.Pagination) ([]models.ExperienceTranslationResponse, error) {
 	var skills []models.ExperienceTranslationResponse
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB.
+	query := repo.db.
 		Table("user_experience_translations").
 		Select(`
 			user_experience_translations.*,
@@ -142,14 +141,17 @@ func (repo *UserExperienceRepository) ReadTranslationsByUserIDLanguageID(userID
         `).
 		Joins("LEFT JOIN user_experiences ON user_experience_translations.user_experience_id = user_experiences.id").
 		Joins("LEFT JOIN companies ON user_experiences.company_id = companies.id").
-		Where("user_experiences.user_id = ?", userID).
-		Where("user_experience_translations.language_id = ?", languageID).
-		Limit(pageSize).Offset(offset)
+		Where("user_experiences.user_id = ?", userID)
+
+	// requested language first, then fallback languages
+	query = wherePreferredTranslation(query, "user_experience_translations", "user_experience_id", languageIDs)
 
 	if isActive != nil {
 		query = query.Where("user_experiences.is_active = ?", isActive)
 	}
 
+	query = applyPage(query, "user_experience_translations.id", "user_experience_translations.id", false, pagination)
+
 	if err := query.Find(&skills).Error; err != nil {
 		return nil, err
 	}
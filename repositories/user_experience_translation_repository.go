@@ -2,16 +2,19 @@ package repositories
 
 import (
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserExperienceTranslationRepository struct{}
+type UserExperienceTranslationRepository struct {
+	db *gorm.DB
+}
 
 func NewUserExperienceTranslationRepository() *UserExperienceTranslationRepository {
-	return &UserExperienceTranslationRepository{}
+	return &UserExperienceTranslationRepository{db: models.DB}
 }
 
 func (repo *UserExperienceTranslationRepository) Create(newData *models.UserExperienceTranslation) (*models.UserExperienceTranslation, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -19,7 +22,7 @@ func (repo *UserExperienceTranslationRepository) Create(newData *models.UserExpe
 
 func (repo *UserExperienceTranslationRepository) Count() (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserExperienceTranslation{})
+	query := repo.db.Model(&models.UserExperienceTranslation{})
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
 	}
@@ -28,7 +31,7 @@ func (repo *UserExperienceTranslationRepository) Count() (int, error) {
 
 func (repo *UserExperienceTranslationRepository) ReadAll() ([]models.UserExperienceTranslation, error) {
 	var datas []models.UserExperienceTranslation
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -48,7 +51,7 @@ func (repo *UserExperienceTranslationRepository) ReadFilteredPaginated(pageSize,
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 
 	// pagination
 	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
@@ -59,7 +62,7 @@ func (repo *UserExperienceTranslationRepository) ReadFilteredPaginated(pageSize,
 
 func (repo *UserExperienceTranslationRepository) Read(ID int64) (*models.UserExperienceTranslation, error) {
 	var data models.UserExperienceTranslation
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -67,21 +70,28 @@ func (repo *UserExperienceTranslationRepository) Read(ID int64) (*models.UserExp
 
 func (repo *UserExperienceTranslationRepository) ReadByLanguageIDUserExperienceID(languageID int64, userExperienceID int64) (*models.UserExperienceTranslation, error) {
 	var data models.UserExperienceTranslation
-	if err := models.DB.Where("language_id = ? AND user_experience_id = ?", languageID, userExperienceID).First(&data).Error; err != nil {
+	if err := repo.db.Where("language_id = ? AND user_experience_id = ?", languageID, userExperienceID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserExperienceTranslationRepository) Update(updatedData *models.UserExperienceTranslation) (*models.UserExperienceTranslation, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserExperienceTranslationRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserExperienceTranslation{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserExperienceTranslation{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserExperienceTranslationRepository) DeleteByLanguageIDUserExperienceID(languageID int64, userExperienceID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("language_id = ? AND user_experience_id = ?", languageID, userExperienceID).
 		Delete(&models.UserExperienceTranslation{}).
 		Error; err != nil {
@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserLanguageRepository struct{}
+type UserLanguageRepository struct {
+	db *gorm.DB
+}
 
 func NewUserLanguageRepository() *UserLanguageRepository {
-	return &UserLanguageRepository{}
+	return &UserLanguageRepository{db: models.DB}
 }
 
 func (repo *UserLanguageRepository) Create(newData *models.UserLanguage) (*models.UserLanguage, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserLanguageRepository) Create(newData *models.UserLanguage) (*model
 
 func (repo *UserLanguageRepository) Count(userID *int64) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserLanguage{})
+	query := repo.db.Model(&models.UserLanguage{})
 
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
@@ -33,7 +36,7 @@ func (repo *UserLanguageRepository) Count(userID *int64) (int, error) {
 }
 
 func (repo *UserLanguageRepository) ReadAll(userID *int64) ([]models.UserLanguage, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserLanguage
 
 	if userID != nil {
@@ -60,7 +63,7 @@ func (repo *UserLanguageRepository) ReadFilteredPaginated(userID *int64, pageSiz
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
 	}
@@ -74,7 +77,7 @@ func (repo *UserLanguageRepository) ReadFilteredPaginated(userID *int64, pageSiz
 
 func (repo *UserLanguageRepository) Read(ID int64) (*models.UserLanguage, error) {
 	var data models.UserLanguage
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -82,21 +85,28 @@ func (repo *UserLanguageRepository) Read(ID int64) (*models.UserLanguage, error)
 
 func (repo *UserLanguageRepository) ReadByUserIDLanguageID(userID int64, languageID int64) (*models.UserLanguage, error) {
 	var data models.UserLanguage
-	if err := models.DB.Where("user_id = ? AND language_id = ?", userID, languageID).First(&data).Error; err != nil {
+	if err := repo.db.Where("user_id = ? AND language_id = ?", userID, languageID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserLanguageRepository) Update(updatedData *models.UserLanguage) (*models.UserLanguage, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserLanguageRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserLanguage{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserLanguage{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserLanguageRepository) DeleteByUserIDLanguageID(userID int64, languageID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("user_id = ? AND language_id = ?", userID, languageID).
 		Delete(&models.UserLanguage{}).
 		Error; err != nil {
@@ -105,21 +115,10 @@ func (repo *UserLanguageRepository) DeleteByUserIDLanguageID(userID int64, langu
 	return nil
 }
 
-func (repo *UserLanguageRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageID int64, isActive *bool, pageNumber int, pageSize int) ([]models.UserLanguageTranslationResponse, error) {
+func (repo *UserLanguageRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageIDs []int64, isActive *bool, pagination helper.Pagination) ([]models.UserLanguageTranslationResponse, error) {
 	var languages []models.UserLanguageTranslationResponse
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB.
+	query := repo.db.
 		Table("user_language_translations").
 		Select(`
             languages.*, 
@@ -129,14 +128,17 @@ func (repo *UserLanguageRepository) ReadTranslationsByUserIDLanguageID(userID in
         `).
 		Joins("LEFT JOIN user_languages ON user_language_translations.user_language_id = user_languages.id").
 		Joins("LEFT JOIN languages ON user_languages.language_id = languages.id").
-		Where("user_languages.user_id = ?", userID).
-		Where("user_language_translations.language_id = ?", languageID).
-		Limit(pageSize).Offset(offset)
+		Where("user_languages.user_id = ?", userID)
+
+	// requested language first, then fallback languages
+	query = wherePreferredTranslation(query, "user_language_translations", "user_language_id", languageIDs)
 
 	if isActive != nil {
 		query = query.Where("user_languages.is_active = ?", isActive)
 	}
 
+	query = applyPage(query, "languages.id", "languages.id", false, pagination)
+
 	if err := query.Find(&languages).Error; err != nil {
 		return nil, err
 	}
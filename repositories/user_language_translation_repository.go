@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserLanguageTranslationRepository struct{}
+type UserLanguageTranslationRepository struct {
+	db *gorm.DB
+}
 
 func NewUserLanguageTranslationRepository() *UserLanguageTranslationRepository {
-	return &UserLanguageTranslationRepository{}
+	return &UserLanguageTranslationRepository{db: models.DB}
 }
 
 func (repo *UserLanguageTranslationRepository) Create(newData *models.UserLanguageTranslation) (*models.UserLanguageTranslation, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserLanguageTranslationRepository) Create(newData *models.UserLangua
 
 func (repo *UserLanguageTranslationRepository) Count(languageID *int64) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserLanguageTranslation{})
+	query := repo.db.Model(&models.UserLanguageTranslation{})
 
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
@@ -33,7 +36,7 @@ func (repo *UserLanguageTranslationRepository) Count(languageID *int64) (int, er
 }
 
 func (repo *UserLanguageTranslationRepository) ReadAll(languageID *int64) ([]models.UserLanguageTranslation, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserLanguageTranslation
 
 	if languageID != nil {
@@ -60,7 +63,7 @@ func (repo *UserLanguageTranslationRepository) ReadFilteredPaginated(languageID
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
 	}
@@ -74,7 +77,7 @@ func (repo *UserLanguageTranslationRepository) ReadFilteredPaginated(languageID
 
 func (repo *UserLanguageTranslationRepository) Read(ID int64) (*models.UserLanguageTranslation, error) {
 	var data models.UserLanguageTranslation
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -82,21 +85,28 @@ func (repo *UserLanguageTranslationRepository) Read(ID int64) (*models.UserLangu
 
 func (repo *UserLanguageTranslationRepository) ReadByLanguageIDUserLanguageID(languageID int64, userLanguageID int64) (*models.UserLanguageTranslation, error) {
 	var data models.UserLanguageTranslation
-	if err := models.DB.Where("language_id = ? AND user_language_id = ?", languageID, userLanguageID).First(&data).Error; err != nil {
+	if err := repo.db.Where("language_id = ? AND user_language_id = ?", languageID, userLanguageID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserLanguageTranslationRepository) Update(updatedData *models.UserLanguageTranslation) (*models.UserLanguageTranslation, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserLanguageTranslationRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserLanguageTranslation{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserLanguageTranslation{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserLanguageTranslationRepository) DeleteByLanguageIDUserLanguageID(languageID int64, userLanguageID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("language_id = ? AND user_language_id = ?", languageID, userLanguageID).
 		Delete(&models.UserLanguageTranslation{}).
 		Error; err != nil {
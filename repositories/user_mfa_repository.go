@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type UserMFARepository struct {
+	db *gorm.DB
+}
+
+func NewUserMFARepository() *UserMFARepository {
+	return &UserMFARepository{db: models.DB}
+}
+
+func (repo *UserMFARepository) ReadByUserID(userID int64) (*models.UserMFA, error) {
+	var data models.UserMFA
+	if err := repo.db.Where("user_id = ?", userID).First(&data).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// save new secret which is not enabled yet, replacing previous pending enrollment
+func (repo *UserMFARepository) SavePending(userID int64, secret string) (*models.UserMFA, error) {
+	data, err := repo.ReadByUserID(userID)
+	if err != nil {
+		data = &models.UserMFA{UserID: uint(userID)}
+	}
+
+	data.Secret = secret
+	data.IsEnabled = false
+	data.LastUsedCounter = 0
+	data.EnabledAt = nil
+
+	if err := repo.db.Save(data).Error; err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// enable mfa and replace recovery codes at once
+func (repo *UserMFARepository) Enable(ID int64, counter int64, recoveryCodeHashes []string) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		var data models.UserMFA
+		if err := tx.First(&data, ID).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		data.IsEnabled = true
+		data.LastUsedCounter = counter
+		data.EnabledAt = &now
+		if err := tx.Save(&data).Error; err != nil {
+			return err
+		}
+
+		return replaceRecoveryCodes(tx, int64(data.UserID), recoveryCodeHashes)
+	})
+}
+
+// save used time step, return false when the code already used (replay)
+func (repo *UserMFARepository) UseCounter(ID int64, counter int64) (bool, error) {
+	result := repo.db.Model(&models.UserMFA{}).
+		Where("id = ? AND last_used_counter < ?", ID, counter).
+		Update("last_used_counter", counter)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repo *UserMFARepository) DeleteByUserID(userID int64) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.UserRecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&models.UserMFA{}).Error
+	})
+}
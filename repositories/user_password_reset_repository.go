@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type UserPasswordResetRepository struct {
+	db *gorm.DB
+}
+
+func NewUserPasswordResetRepository() *UserPasswordResetRepository {
+	return &UserPasswordResetRepository{db: models.DB}
+}
+
+// save new reset token and invalidate the previous unused token of the user
+func (repo *UserPasswordResetRepository) Create(newData *models.UserPasswordReset) (*models.UserPasswordReset, error) {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.UserPasswordReset{}).
+			Where("user_id = ? AND used_at IS NULL", newData.UserID).
+			Update("used_at", time.Now()).Error; err != nil {
+			return err
+		}
+		return tx.Create(newData).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newData, nil
+}
+
+func (repo *UserPasswordResetRepository) ReadByTokenHash(tokenHash string) (*models.UserPasswordReset, error) {
+	var data models.UserPasswordReset
+	if err := repo.db.Where("token_hash = ?", tokenHash).First(&data).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// mark token as used, return ErrRecordNotFound when it already used
+func (repo *UserPasswordResetRepository) MarkUsed(ID int64) error {
+	result := repo.db.Model(&models.UserPasswordReset{}).
+		Where("id = ? AND used_at IS NULL", ID).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// mark token as used and save the new password at once, token stay unused when the password is not saved.
+// return ErrRecordNotFound when it already used
+func (repo *UserPasswordResetRepository) UseToUpdatePassword(ID int64, userID int64, password string) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := (&UserPasswordResetRepository{db: tx}).MarkUsed(ID); err != nil {
+			return err
+		}
+		return (&UserRepository{db: tx}).UpdatePassword(userID, password)
+	})
+}
@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserPositionRepository struct{}
+type UserPositionRepository struct {
+	db *gorm.DB
+}
 
 func NewUserPositionRepository() *UserPositionRepository {
-	return &UserPositionRepository{}
+	return &UserPositionRepository{db: models.DB}
 }
 
 func (repo *UserPositionRepository) Create(newData *models.UserPosition) (*models.UserPosition, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserPositionRepository) Create(newData *models.UserPosition) (*model
 
 func (repo *UserPositionRepository) Count(userID *int64) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserPosition{})
+	query := repo.db.Model(&models.UserPosition{})
 
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
@@ -33,7 +36,7 @@ func (repo *UserPositionRepository) Count(userID *int64) (int, error) {
 }
 
 func (repo *UserPositionRepository) ReadAll(userID *int64, isActive *bool) ([]models.UserPosition, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserPosition
 
 	if userID != nil {
@@ -64,7 +67,7 @@ func (repo *UserPositionRepository) ReadFilteredPaginated(userID *int64, pageSiz
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if userID != nil {
 		query = query.Where("user_id LIKE ?", userID)
 	}
@@ -78,14 +81,21 @@ func (repo *UserPositionRepository) ReadFilteredPaginated(userID *int64, pageSiz
 
 func (repo *UserPositionRepository) Read(ID int64) (*models.UserPosition, error) {
 	var data models.UserPosition
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserPositionRepository) Update(updatedData *models.UserPosition) (*models.UserPosition, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserPositionRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserPosition{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserPosition{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
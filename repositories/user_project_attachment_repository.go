@@ -2,16 +2,19 @@ package repositories
 
 import (
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserProjectAttachmentRepository struct{}
+type UserProjectAttachmentRepository struct {
+	db *gorm.DB
+}
 
 func NewUserProjectAttachmentRepository() *UserProjectAttachmentRepository {
-	return &UserProjectAttachmentRepository{}
+	return &UserProjectAttachmentRepository{db: models.DB}
 }
 
 func (repo *UserProjectAttachmentRepository) Create(newData *models.UserProjectAttachment) (*models.UserProjectAttachment, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -19,7 +22,7 @@ func (repo *UserProjectAttachmentRepository) Create(newData *models.UserProjectA
 
 func (repo *UserProjectAttachmentRepository) Count() (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserProjectAttachment{})
+	query := repo.db.Model(&models.UserProjectAttachment{})
 
 	if err := query.Count(&count).Error; err != nil {
 		return 0, err
@@ -28,7 +31,7 @@ func (repo *UserProjectAttachmentRepository) Count() (int, error) {
 }
 
 func (repo *UserProjectAttachmentRepository) ReadAll(userProjectID *int64) ([]models.UserProjectAttachment, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserProjectAttachment
 
 	if userProjectID != nil {
@@ -55,7 +58,7 @@ func (repo *UserProjectAttachmentRepository) ReadFilteredPaginated(pageSize, pag
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 
 	// pagination
 	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
@@ -66,14 +69,21 @@ func (repo *UserProjectAttachmentRepository) ReadFilteredPaginated(pageSize, pag
 
 func (repo *UserProjectAttachmentRepository) Read(ID int64) (*models.UserProjectAttachment, error) {
 	var data models.UserProjectAttachment
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserProjectAttachmentRepository) Update(updatedData *models.UserProjectAttachment) (*models.UserProjectAttachment, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserProjectAttachmentRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserProjectAttachment{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserProjectAttachment{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
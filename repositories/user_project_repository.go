@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserProjectRepository struct{}
+type UserProjectRepository struct {
+	db *gorm.DB
+}
 
 func NewUserProjectRepository() *UserProjectRepository {
-	return &UserProjectRepository{}
+	return &UserProjectRepository{db: models.DB}
 }
 
 func (repo *UserProjectRepository) Create(newData *models.UserProject) (*models.UserProject, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserProjectRepository) Create(newData *models.UserProject) (*models.
 
 func (repo *UserProjectRepository) Count(userID *int64, projectPlatformID *int64, isActive *bool) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserProject{})
+	query := repo.db.Model(&models.UserProject{})
 
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
@@ -41,7 +44,7 @@ func (repo *UserProjectRepository) Count(userID *int64, projectPlatformID *int64
 }
 
 func (repo *UserProjectRepository) ReadAll(userID *int64, projectPlatformID *int64, isActive *bool) ([]models.UserProject, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserProject
 
 	if userID != nil {
@@ -76,7 +79,7 @@ func (repo *UserProjectRepository) ReadFilteredPaginated(userID *int64, projectP
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
 	}
@@ -98,15 +101,20 @@ func (repo *UserProjectRepository) ReadFilteredPaginated(userID *int64, projectP
 
 func (repo *UserProjectRepository) Read(ID int64) (*models.UserProject, error) {
 	var data models.UserProject
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
-func (repo *UserProjectRepository) ReadByUserIDSlugLanguageID(userID int64, slug string, languageID int64) (*models.ProjectTranslationResponse, error) {
+func (repo *UserProjectRepository) ReadByUserIDSlugLanguageID(userID int64, slug string, languageIDs []int64, isActive *bool) (*models.ProjectTranslationResponse, error) {
 	var data models.ProjectTranslationResponse
-	if err := models.DB.
+	query := repo.db
+	if isActive != nil {
+		query = query.Where("user_projects.is_active = ?", isActive)
+	}
+
+	if err := query.
 		Table("user_project_translations").
 		Select(`
 		user_project_translations.*,
@@ -118,35 +126,32 @@ func (repo *UserProjectRepository) ReadByUserIDSlugLanguageID(userID int64, slug
 	`).
 		Joins("LEFT JOIN user_projects ON user_project_translations.user_project_id = user_projects.id").
 		Where("user_projects.user_id = ? AND user_projects.slug = ?", userID, slug).
-		Where("user_project_translations.language_id = ?", languageID).
-		First(&data).Error; err != nil {
+		Where("user_project_translations.language_id IN ?", languageIDs).
+		Clauses(orderByLanguageChain("user_project_translations.language_id", languageIDs)).
+		Take(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserProjectRepository) Update(updatedData *models.UserProject) (*models.UserProject, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserProjectRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserProject{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserProject{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
-func (repo *UserProjectRepository) ReadTranslationsByUserIDLanguageID(userID int64, projectPlatformID *int64, languageID int64, isActive *bool, pageNumber int, pageSize int) ([]models.ProjectTranslationResponse, error) {
+func (repo *UserProjectRepository) ReadTranslationsByUserIDLanguageID(userID int64, projectPlatformID *int64, languageIDs []int64, isActive *bool, pagination helper.Pagination) ([]models.ProjectTranslationResponse, error) {
 	var skills []models.ProjectTranslationResponse
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB.
+	query := repo.db.
 		Table("user_project_translations").
 		Select(`
 			user_project_translations.*,
@@ -157,9 +162,10 @@ func (repo *UserProjectRepository) ReadTranslationsByUserIDLanguageID(userID int
 			user_projects.project_platform_id
         `).
 		Joins("LEFT JOIN user_projects ON user_project_translations.user_project_id = user_projects.id").
-		Where("user_projects.user_id = ?", userID).
-		Where("user_project_translations.language_id = ?", languageID).
-		Limit(pageSize).Offset(offset)
+		Where("user_projects.user_id = ?", userID)
+
+	// requested language first, then fallback languages
+	query = wherePreferredTranslation(query, "user_project_translations", "user_project_id", languageIDs)
 
 	if isActive != nil {
 		query = query.Where("user_projects.is_active = ?", isActive)
@@ -169,6 +175,8 @@ func (repo *UserProjectRepository) ReadTranslationsByUserIDLanguageID(userID int
 		query = query.Where("user_projects.project_platform_id = ?", projectPlatformID)
 	}
 
+	query = applyPage(query, "user_project_translations.id", "user_project_translations.id", false, pagination)
+
 	if err := query.Find(&skills).Error; err != nil {
 		return nil, err
 	}
@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserProjectTranslationRepository struct{}
+type UserProjectTranslationRepository struct {
+	db *gorm.DB
+}
 
 func NewUserProjectTranslationRepository() *UserProjectTranslationRepository {
-	return &UserProjectTranslationRepository{}
+	return &UserProjectTranslationRepository{db: models.DB}
 }
 
 func (repo *UserProjectTranslationRepository) Create(newData *models.UserProjectTranslation) (*models.UserProjectTranslation, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserProjectTranslationRepository) Create(newData *models.UserProject
 
 func (repo *UserProjectTranslationRepository) Count(languageID *int64) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserProjectTranslation{})
+	query := repo.db.Model(&models.UserProjectTranslation{})
 
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
@@ -33,7 +36,7 @@ func (repo *UserProjectTranslationRepository) Count(languageID *int64) (int, err
 }
 
 func (repo *UserProjectTranslationRepository) ReadAll(languageID *int64) ([]models.UserProjectTranslation, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserProjectTranslation
 
 	if languageID != nil {
@@ -60,7 +63,7 @@ func (repo *UserProjectTranslationRepository) ReadFilteredPaginated(languageID *
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if languageID != nil {
 		query = query.Where(helper.FilterLanguageIDEqual, languageID)
 	}
@@ -74,7 +77,7 @@ func (repo *UserProjectTranslationRepository) ReadFilteredPaginated(languageID *
 
 func (repo *UserProjectTranslationRepository) Read(ID int64) (*models.UserProjectTranslation, error) {
 	var data models.UserProjectTranslation
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -82,21 +85,28 @@ func (repo *UserProjectTranslationRepository) Read(ID int64) (*models.UserProjec
 
 func (repo *UserProjectTranslationRepository) ReadByLanguageIDUserProjectID(languageID int64, userProjectID int64) (*models.UserProjectTranslation, error) {
 	var data models.UserProjectTranslation
-	if err := models.DB.Where("language_id = ? AND user_project_id = ?", languageID, userProjectID).First(&data).Error; err != nil {
+	if err := repo.db.Where("language_id = ? AND user_project_id = ?", languageID, userProjectID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserProjectTranslationRepository) Update(updatedData *models.UserProjectTranslation) (*models.UserProjectTranslation, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserProjectTranslationRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserProjectTranslation{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserProjectTranslation{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserProjectTranslationRepository) DeleteByLanguageIDUserProjectID(languageID int64, userProjectID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("language_id = ? AND user_project_id = ?", languageID, userProjectID).
 		Delete(&models.UserProjectTranslation{}).
 		Error; err != nil {
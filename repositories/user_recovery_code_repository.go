@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type UserRecoveryCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewUserRecoveryCodeRepository() *UserRecoveryCodeRepository {
+	return &UserRecoveryCodeRepository{db: models.DB}
+}
+
+func (repo *UserRecoveryCodeRepository) Replace(userID int64, codeHashes []string) error {
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, codeHashes)
+	})
+}
+
+// mark recovery code as used, return false when code not found or already used
+func (repo *UserRecoveryCodeRepository) Use(userID int64, codeHash string) (bool, error) {
+	result := repo.db.Model(&models.UserRecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (repo *UserRecoveryCodeRepository) CountUnused(userID int64) (int, error) {
+	var count int64
+	if err := repo.db.Model(&models.UserRecoveryCode{}).Where("user_id = ? AND used_at IS NULL", userID).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID int64, codeHashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&models.UserRecoveryCode{}).Error; err != nil {
+		return err
+	}
+
+	for _, codeHash := range codeHashes {
+		newData := models.UserRecoveryCode{
+			UserID:   uint(userID),
+			CodeHash: codeHash,
+		}
+		if err := tx.Create(&newData).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
+)
+
+type UserRefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+func NewUserRefreshTokenRepository() *UserRefreshTokenRepository {
+	return &UserRefreshTokenRepository{db: models.DB}
+}
+
+func (repo *UserRefreshTokenRepository) Create(newData *models.UserRefreshToken) (*models.UserRefreshToken, error) {
+	if err := repo.db.Create(newData).Error; err != nil {
+		return nil, err
+	}
+	return newData, nil
+}
+
+func (repo *UserRefreshTokenRepository) ReadByTokenHash(tokenHash string) (*models.UserRefreshToken, error) {
+	var data models.UserRefreshToken
+	if err := repo.db.Where("token_hash = ?", tokenHash).First(&data).Error; err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// revoke old refresh token and save the new one of the same family at once
+func (repo *UserRefreshTokenRepository) Rotate(oldData *models.UserRefreshToken, newData *models.UserRefreshToken) (*models.UserRefreshToken, error) {
+	err := repo.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.UserRefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", oldData.ID).
+			Update("revoked_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		// already rotated by another request
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return tx.Create(newData).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newData, nil
+}
+
+func (repo *UserRefreshTokenRepository) RevokeByFamilyID(familyID string) error {
+	return repo.revoke("family_id", familyID)
+}
+
+func (repo *UserRefreshTokenRepository) RevokeByUserID(userID int64) error {
+	return repo.revoke("user_id", userID)
+}
+
+// revoke refresh token and put every access token which has not expired to revocation list,
+// a rotated refresh token is already revoked but its access token may still be in use
+func (repo *UserRefreshTokenRepository) revoke(column string, value interface{}) error {
+	now := time.Now()
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		var datas []models.UserRefreshToken
+		if err := tx.Where(column+" = ? AND access_token_expires_at > ?", value, now).Find(&datas).Error; err != nil {
+			return err
+		}
+
+		for _, data := range datas {
+			revokedToken := models.RevokedToken{
+				JTI:       data.AccessTokenID,
+				UserID:    int64(data.UserID),
+				ExpiresAt: data.AccessTokenExpiresAt,
+			}
+			if err := tx.Where(models.RevokedToken{JTI: data.AccessTokenID}).FirstOrCreate(&revokedToken).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&models.UserRefreshToken{}).
+			Where(column+" = ? AND revoked_at IS NULL", value).
+			Update("revoked_at", now).Error
+	})
+}
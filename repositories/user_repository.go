@@ -1,14 +1,21 @@
 package repositories
 
 import (
+	"errors"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
 	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
 )
 
-type UserRepository struct{}
+type UserRepository struct {
+	db *gorm.DB
+}
 
 func NewUserRepository() *UserRepository {
-	return &UserRepository{}
+	return &UserRepository{db: models.DB}
 }
 
 func (repo *UserRepository) HashUserPassword(password string) (string, error) {
@@ -31,7 +38,7 @@ func (repo *UserRepository) CompareUserPassword(hashedPassword, plainPassword st
 
 func (repo *UserRepository) Count(name string) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.User{})
+	query := repo.db.Model(&models.User{})
 	if name != "" {
 		query = query.Where("name LIKE ?", "%"+name+"%")
 	}
@@ -52,10 +59,11 @@ func (repo *UserRepository) Create(userInput *models.UserCreateForm) (*models.Us
 		Username: userInput.Username,
 		Name:     userInput.Name,
 		Password: hashedPassword,
+		Role:     models.UserRoleOwner,
 	}
 
 	// Insert user into database
-	if err := models.DB.Create(&newData).Error; err != nil {
+	if err := repo.db.Create(&newData).Error; err != nil {
 		return nil, err
 	}
 	return &newData, nil
@@ -63,34 +71,23 @@ func (repo *UserRepository) Create(userInput *models.UserCreateForm) (*models.Us
 
 func (repo *UserRepository) ReadAll() ([]models.User, error) {
 	var datas []models.User
-	if err := models.DB.Find(&datas).Error; err != nil {
+	if err := repo.db.Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
 }
 
-func (repo *UserRepository) ReadFilteredPaginated(nameFilter string, pageSize, pageNumber int) ([]models.User, error) {
+func (repo *UserRepository) ReadFilteredPaginated(nameFilter string, pagination helper.Pagination) ([]models.User, error) {
 	var datas []models.User
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate off set
-	offset := (pageNumber - 1) * pageSize
-
 	// filter name
-	query := models.DB
+	query := repo.db
 	if nameFilter != "" {
 		query = query.Where("name LIKE ?", "%"+nameFilter+"%")
 	}
 
 	// pagination
-	if err := query.Offset(offset).Limit(pageSize).Find(&datas).Error; err != nil {
+	if err := applyPage(query, "id", "id", false, pagination).Find(&datas).Error; err != nil {
 		return nil, err
 	}
 	return datas, nil
@@ -98,7 +95,7 @@ func (repo *UserRepository) ReadFilteredPaginated(nameFilter string, pageSize, p
 
 func (repo *UserRepository) Read(ID int64) (*models.User, error) {
 	var data models.User
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -106,7 +103,7 @@ func (repo *UserRepository) Read(ID int64) (*models.User, error) {
 
 func (repo *UserRepository) ReadByUsername(username string) (*models.User, error) {
 	var data models.User
-	if err := models.DB.Where("username = ?", username).First(&data).Error; err != nil {
+	if err := repo.db.Where("username = ?", username).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -120,16 +117,108 @@ func (repo *UserRepository) Update(ID int64, updatedUser *models.UserEditForm) e
 
 	existingData.Name = updatedUser.Name
 	existingData.Username = updatedUser.Username
+	if updatedUser.DefaultLanguageID != nil {
+		if *updatedUser.DefaultLanguageID == 0 {
+			existingData.DefaultLanguageID = nil
+		} else {
+			existingData.DefaultLanguageID = updatedUser.DefaultLanguageID
+		}
+	}
+
+	if err := repo.db.Save(existingData).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *UserRepository) UpdatePassword(ID int64, password string) error {
+	existingData, err := repo.Read(ID)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := repo.HashUserPassword(password)
+	if err != nil {
+		return err
+	}
+	existingData.Password = hashedPassword
 
-	if err := models.DB.Save(existingData).Error; err != nil {
+	if err := repo.db.Save(existingData).Error; err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (repo *UserRepository) UpdateRole(ID int64, role string) error {
+	existingData, err := repo.Read(ID)
+	if err != nil {
+		return err
+	}
+
+	existingData.Role = role
+
+	if err := repo.db.Save(existingData).Error; err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (repo *UserRepository) CountByRole(role string) (int, error) {
+	var count int64
+	if err := repo.db.Model(&models.User{}).Where("role = ?", role).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// seed admin from configuration, only when there is no admin yet
+func (repo *UserRepository) SeedAdmin(admin config.AdminConfig) error {
+	if admin.Username == "" {
+		return nil
+	}
+
+	totalAdmin, err := repo.CountByRole(models.UserRoleAdmin)
+	if err != nil {
+		return err
+	}
+	if totalAdmin > 0 {
+		return nil
+	}
+
+	if admin.Password == "" {
+		return errors.New("admin password is required to create admin user")
+	}
+
+	// promote existing user only when it is proven to be the configured admin, anyone can register the username
+	if existingData, err := repo.ReadByUsername(admin.Username); err == nil {
+		if err := repo.CompareUserPassword(existingData.Password, admin.Password); err != nil {
+			return errors.New("user with admin username already exist and its password does not match admin password")
+		}
+		return repo.UpdateRole(existingData.ID, models.UserRoleAdmin)
+	}
+
+	name := admin.Name
+	if name == "" {
+		name = admin.Username
+	}
+
+	newData, err := repo.Create(&models.UserCreateForm{
+		Name:     name,
+		Username: admin.Username,
+		Password: admin.Password,
+	})
+	if err != nil {
+		return err
+	}
+
+	return repo.UpdateRole(newData.ID, models.UserRoleAdmin)
+}
+
 func (repo *UserRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.User{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.User{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
@@ -3,16 +3,19 @@ package repositories
 import (
 	"github.com/FRanggaY/personal-portfolio-api/helper"
 	"github.com/FRanggaY/personal-portfolio-api/models"
+	"gorm.io/gorm"
 )
 
-type UserSkillRepository struct{}
+type UserSkillRepository struct {
+	db *gorm.DB
+}
 
 func NewUserSkillRepository() *UserSkillRepository {
-	return &UserSkillRepository{}
+	return &UserSkillRepository{db: models.DB}
 }
 
 func (repo *UserSkillRepository) Create(newData *models.UserSkill) (*models.UserSkill, error) {
-	if err := models.DB.Create(newData).Error; err != nil {
+	if err := repo.db.Create(newData).Error; err != nil {
 		return nil, err
 	}
 	return newData, nil
@@ -20,7 +23,7 @@ func (repo *UserSkillRepository) Create(newData *models.UserSkill) (*models.User
 
 func (repo *UserSkillRepository) Count(userID *int64, isActive *bool) (int, error) {
 	var count int64
-	query := models.DB.Model(&models.UserSkill{})
+	query := repo.db.Model(&models.UserSkill{})
 
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
@@ -37,7 +40,7 @@ func (repo *UserSkillRepository) Count(userID *int64, isActive *bool) (int, erro
 }
 
 func (repo *UserSkillRepository) ReadAll(userID *int64) ([]models.UserSkill, error) {
-	query := models.DB
+	query := repo.db
 	var datas []models.UserSkill
 
 	if userID != nil {
@@ -64,7 +67,7 @@ func (repo *UserSkillRepository) ReadFilteredPaginated(userID *int64, pageSize,
 	// calculate offset
 	offset := (pageNumber - 1) * pageSize
 
-	query := models.DB
+	query := repo.db
 	if userID != nil {
 		query = query.Where(helper.FilterUserIDEqual, userID)
 	}
@@ -78,7 +81,7 @@ func (repo *UserSkillRepository) ReadFilteredPaginated(userID *int64, pageSize,
 
 func (repo *UserSkillRepository) Read(ID int64) (*models.UserSkill, error) {
 	var data models.UserSkill
-	if err := models.DB.First(&data, ID).Error; err != nil {
+	if err := repo.db.First(&data, ID).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
@@ -86,21 +89,28 @@ func (repo *UserSkillRepository) Read(ID int64) (*models.UserSkill, error) {
 
 func (repo *UserSkillRepository) ReadByUserIDSkillID(userID int64, skillID int64) (*models.UserSkill, error) {
 	var data models.UserSkill
-	if err := models.DB.Where("user_id = ? AND skill_id = ?", userID, skillID).First(&data).Error; err != nil {
+	if err := repo.db.Where("user_id = ? AND skill_id = ?", userID, skillID).First(&data).Error; err != nil {
 		return nil, err
 	}
 	return &data, nil
 }
 
+func (repo *UserSkillRepository) Update(updatedData *models.UserSkill) (*models.UserSkill, error) {
+	if err := repo.db.Save(updatedData).Error; err != nil {
+		return nil, err
+	}
+	return updatedData, nil
+}
+
 func (repo *UserSkillRepository) Delete(ID int64) error {
-	if err := models.DB.Delete(&models.UserSkill{}, ID).Error; err != nil {
+	if err := repo.db.Delete(&models.UserSkill{}, ID).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 func (repo *UserSkillRepository) DeleteByUserIDSkillID(userID int64, skillID int64) error {
-	if err := models.DB.
+	if err := repo.db.
 		Where("user_id = ? AND skill_id = ?", userID, skillID).
 		Delete(&models.UserSkill{}).
 		Error; err != nil {
@@ -109,21 +119,10 @@ func (repo *UserSkillRepository) DeleteByUserIDSkillID(userID int64, skillID int
 	return nil
 }
 
-func (repo *UserSkillRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageID int64, isActive *bool, pageNumber int, pageSize int) ([]models.SkillTranslationResponse, error) {
+func (repo *UserSkillRepository) ReadTranslationsByUserIDLanguageID(userID int64, languageIDs []int64, isActive *bool, pagination helper.Pagination) ([]models.SkillTranslationResponse, error) {
 	var skills []models.SkillTranslationResponse
 
-	// default
-	if pageSize <= 0 {
-		pageSize = 5
-	}
-	if pageNumber <= 0 {
-		pageNumber = 1
-	}
-
-	// calculate offset
-	offset := (pageNumber - 1) * pageSize
-
-	query := models.DB.
+	query := repo.db.
 		Table("skill_translations").
 		Select(`
             skills.*, 
@@ -132,14 +131,17 @@ func (repo *UserSkillRepository) ReadTranslationsByUserIDLanguageID(userID int64
         `).
 		Joins("LEFT JOIN skills ON skill_translations.skill_id = skills.id").
 		Joins("LEFT JOIN user_skills ON skills.id = user_skills.skill_id").
-		Where("user_skills.user_id = ?", userID).
-		Where("skill_translations.language_id = ?", languageID).
-		Limit(pageSize).Offset(offset)
+		Where("user_skills.user_id = ?", userID)
+
+	// requested language first, then fallback languages
+	query = wherePreferredTranslation(query, "skill_translations", "skill_id", languageIDs)
 
 	if isActive != nil {
 		query = query.Where("user_skills.is_active = ?", isActive)
 	}
 
+	query = applyPage(query, "skills.id", "skills.id", false, pagination)
+
 	if err := query.Find(&skills).Error; err != nil {
 		return nil, err
 	}
@@ -0,0 +1,94 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// max characters of snippet
+const snippetLength = 160
+
+type wordSpan struct {
+	start, end int
+	matched    bool
+}
+
+// html escaped snippet around first matched word, matched words wrapped in <mark>
+func Highlight(text string, queryTerms []string) string {
+	runes := []rune(text)
+
+	var words []wordSpan
+	firstMatch := -1
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			i++
+			continue
+		}
+		start := i
+		for i < len(runes) && isWordRune(runes[i]) {
+			i++
+		}
+		matched := matchTerm(strings.ToLower(string(runes[start:i])), queryTerms)
+		if matched && firstMatch < 0 {
+			firstMatch = start
+		}
+		words = append(words, wordSpan{start: start, end: i, matched: matched})
+	}
+
+	// window start a bit before first match
+	windowStart, windowEnd := 0, len(runes)
+	if len(runes) > snippetLength {
+		if firstMatch > snippetLength/4 {
+			windowStart = firstMatch - snippetLength/4
+		}
+		windowEnd = windowStart + snippetLength
+		if windowEnd > len(runes) {
+			windowEnd = len(runes)
+			windowStart = windowEnd - snippetLength
+		}
+		// do not cut a word
+		for _, word := range words {
+			if word.start < windowStart && word.end > windowStart {
+				windowStart = word.end
+			}
+			if word.start < windowEnd && word.end > windowEnd {
+				windowEnd = word.start
+			}
+		}
+	}
+
+	var builder strings.Builder
+	if windowStart > 0 {
+		builder.WriteString("…")
+	}
+	position := windowStart
+	for _, word := range words {
+		if !word.matched || word.start < windowStart || word.end > windowEnd {
+			continue
+		}
+		builder.WriteString(html.EscapeString(string(runes[position:word.start])))
+		builder.WriteString("<mark>")
+		builder.WriteString(html.EscapeString(string(runes[word.start:word.end])))
+		builder.WriteString("</mark>")
+		position = word.end
+	}
+	builder.WriteString(html.EscapeString(string(runes[position:windowEnd])))
+	if windowEnd < len(runes) {
+		builder.WriteString("…")
+	}
+	return strings.TrimSpace(builder.String())
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func matchTerm(word string, queryTerms []string) bool {
+	for _, queryTerm := range queryTerms {
+		if word == queryTerm || (len([]rune(queryTerm)) >= prefixMinLength && strings.HasPrefix(word, queryTerm)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,35 @@
+package search
+
+// searchable field of document, weight raise the score of term found in the field
+type Field struct {
+	Name   string
+	Text   string
+	Weight float64
+}
+
+type Document struct {
+	Type   string
+	ID     int64
+	Title  string
+	Fields []Field
+	// extra value returned as is, e.g. project slug
+	Extra map[string]interface{}
+}
+
+type Result struct {
+	Type    string                 `json:"type"`
+	ID      int64                  `json:"id"`
+	Title   string                 `json:"title"`
+	Score   float64                `json:"score"`
+	Field   string                 `json:"field"`
+	Snippet string                 `json:"snippet"`
+	Extra   map[string]interface{} `json:"extra,omitempty"`
+}
+
+// search engine of portfolio documents, implementation can be in process inverted index, mysql fulltext, etc
+type Index interface {
+	// document with the same type and id is replaced
+	Add(documents ...Document)
+	Remove(documentType string, ID int64)
+	Search(query string, limit int) []Result
+}
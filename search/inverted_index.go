@@ -0,0 +1,211 @@
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// term found by prefix only get part of the score
+const prefixMatchFactor = 0.5
+
+// minimal query term length to match by prefix, "go" does not match "google"
+const prefixMinLength = 3
+
+type posting struct {
+	document int
+	field    int
+	count    int
+}
+
+type documentKey struct {
+	Type string
+	ID   int64
+}
+
+// in process inverted index, built from documents in memory
+type InvertedIndex struct {
+	mutex        sync.RWMutex
+	documents    map[int]Document
+	documentKeys map[documentKey]int
+	nextDocument int
+	postings     map[string][]posting
+}
+
+func NewInvertedIndex() *InvertedIndex {
+	return &InvertedIndex{
+		documents:    map[int]Document{},
+		documentKeys: map[documentKey]int{},
+		postings:     map[string][]posting{},
+	}
+}
+
+// add document, document with the same type and id is replaced
+func (index *InvertedIndex) Add(documents ...Document) {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	for _, document := range documents {
+		index.remove(document.Type, document.ID)
+
+		documentIndex := index.nextDocument
+		index.nextDocument++
+		index.documents[documentIndex] = document
+		index.documentKeys[documentKey{Type: document.Type, ID: document.ID}] = documentIndex
+
+		for fieldIndex, field := range document.Fields {
+			counts := map[string]int{}
+			for _, term := range Tokenize(field.Text) {
+				counts[term]++
+			}
+			for term, count := range counts {
+				index.postings[term] = append(index.postings[term], posting{document: documentIndex, field: fieldIndex, count: count})
+			}
+		}
+	}
+}
+
+func (index *InvertedIndex) Remove(documentType string, ID int64) {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	index.remove(documentType, ID)
+}
+
+// drop document and its postings, term without posting left is dropped too
+func (index *InvertedIndex) remove(documentType string, ID int64) {
+	key := documentKey{Type: documentType, ID: ID}
+	documentIndex, ok := index.documentKeys[key]
+	if !ok {
+		return
+	}
+
+	for _, field := range index.documents[documentIndex].Fields {
+		for _, term := range Tokenize(field.Text) {
+			postings := index.postings[term][:0]
+			for _, posting := range index.postings[term] {
+				if posting.document != documentIndex {
+					postings = append(postings, posting)
+				}
+			}
+			if len(postings) == 0 {
+				delete(index.postings, term)
+			} else {
+				index.postings[term] = postings
+			}
+		}
+	}
+
+	delete(index.documents, documentIndex)
+	delete(index.documentKeys, key)
+}
+
+// rank document by tf-idf of query terms multiplied by field weight, document matching more query terms rank higher
+func (index *InvertedIndex) Search(query string, limit int) []Result {
+	index.mutex.RLock()
+	defer index.mutex.RUnlock()
+
+	queryTerms := uniqueTerms(Tokenize(query))
+	if len(queryTerms) == 0 || len(index.documents) == 0 {
+		return []Result{}
+	}
+
+	type match struct {
+		score      float64
+		terms      map[string]bool
+		fieldScore map[int]float64
+	}
+	matches := map[int]*match{}
+	totalDocuments := float64(len(index.documents))
+
+	for _, queryTerm := range queryTerms {
+		for term, postings := range index.postings {
+			factor := 0.0
+			switch {
+			case term == queryTerm:
+				factor = 1
+			case len([]rune(queryTerm)) >= prefixMinLength && strings.HasPrefix(term, queryTerm):
+				factor = prefixMatchFactor
+			default:
+				continue
+			}
+
+			idf := math.Log(1 + totalDocuments/float64(countDocuments(postings)))
+			for _, posting := range postings {
+				found, ok := matches[posting.document]
+				if !ok {
+					found = &match{terms: map[string]bool{}, fieldScore: map[int]float64{}}
+					matches[posting.document] = found
+				}
+				weight := index.documents[posting.document].Fields[posting.field].Weight
+				if weight <= 0 {
+					weight = 1
+				}
+				score := factor * weight * (1 + math.Log(float64(posting.count))) * idf
+				found.score += score
+				found.fieldScore[posting.field] += score
+				found.terms[queryTerm] = true
+			}
+		}
+	}
+
+	results := make([]Result, 0, len(matches))
+	for documentIndex, found := range matches {
+		document := index.documents[documentIndex]
+		// coverage bonus, all query terms found double the score
+		score := found.score * (1 + float64(len(found.terms))/float64(len(queryTerms)))
+
+		bestField := 0
+		for field, fieldScore := range found.fieldScore {
+			if fieldScore > found.fieldScore[bestField] || (fieldScore == found.fieldScore[bestField] && field < bestField) {
+				bestField = field
+			}
+		}
+
+		results = append(results, Result{
+			Type:    document.Type,
+			ID:      document.ID,
+			Title:   document.Title,
+			Score:   math.Round(score*1000) / 1000,
+			Field:   document.Fields[bestField].Name,
+			Snippet: Highlight(document.Fields[bestField].Text, queryTerms),
+			Extra:   document.Extra,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if results[i].Type != results[j].Type {
+			return results[i].Type < results[j].Type
+		}
+		return results[i].ID < results[j].ID
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results
+}
+
+func uniqueTerms(terms []string) []string {
+	seen := map[string]bool{}
+	var result []string
+	for _, term := range terms {
+		if !seen[term] {
+			seen[term] = true
+			result = append(result, term)
+		}
+	}
+	return result
+}
+
+func countDocuments(postings []posting) int {
+	documents := map[int]bool{}
+	for _, posting := range postings {
+		documents[posting.document] = true
+	}
+	return len(documents)
+}
@@ -0,0 +1,128 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func resultKeys(results []Result) []documentKey {
+	keys := make([]documentKey, 0, len(results))
+	for _, result := range results {
+		keys = append(keys, documentKey{Type: result.Type, ID: result.ID})
+	}
+	return keys
+}
+
+func newTestIndex() *InvertedIndex {
+	index := NewInvertedIndex()
+	index.Add(
+		Document{Type: "project", ID: 1, Title: "Payment Gateway", Fields: []Field{
+			{Name: "name", Text: "Payment Gateway", Weight: 3},
+			{Name: "description", Text: "Fintech payment service written in Golang", Weight: 1},
+		}},
+		Document{Type: "project", ID: 2, Title: "Blog", Fields: []Field{
+			{Name: "name", Text: "Blog", Weight: 3},
+			{Name: "description", Text: "Personal blog, mention of golang in passing", Weight: 1},
+		}},
+		Document{Type: "skill", ID: 1, Title: "Golang", Fields: []Field{
+			{Name: "name", Text: "Golang", Weight: 3},
+		}},
+	)
+	return index
+}
+
+func TestInvertedIndexRanking(t *testing.T) {
+	index := newTestIndex()
+
+	results := index.Search("golang", 0)
+	want := []documentKey{{"skill", 1}, {"project", 1}, {"project", 2}}
+	if got := resultKeys(results); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Search(golang) = %v, want %v", got, want)
+	}
+	if results[0].Field != "name" || results[0].Snippet != "<mark>Golang</mark>" {
+		t.Errorf("best result field = %s snippet = %s", results[0].Field, results[0].Snippet)
+	}
+
+	// document matching every query term rank above one matching only some
+	results = index.Search("golang fintech", 0)
+	if len(results) != 3 || results[0].Type != "project" || results[0].ID != 1 {
+		t.Fatalf("Search(golang fintech) = %v, want project 1 first", resultKeys(results))
+	}
+	if results[0].Field != "description" {
+		t.Errorf("field = %s, want description", results[0].Field)
+	}
+
+	for i := 1; i < len(results); i++ {
+		if results[i-1].Score < results[i].Score {
+			t.Errorf("results not sorted by score: %v", results)
+		}
+	}
+
+	if results := index.Search("golang", 1); len(results) != 1 {
+		t.Errorf("Search with limit 1 returned %d results", len(results))
+	}
+}
+
+func TestInvertedIndexPrefix(t *testing.T) {
+	index := newTestIndex()
+
+	results := index.Search("gol", 0)
+	if len(results) != 3 {
+		t.Errorf("Search(gol) = %v, want every golang document", resultKeys(results))
+	}
+	exact := index.Search("golang", 0)
+	if results[0].Score >= exact[0].Score {
+		t.Errorf("prefix score %v is not lower than exact score %v", results[0].Score, exact[0].Score)
+	}
+
+	// too short to match by prefix
+	if results := index.Search("go", 0); len(results) != 0 {
+		t.Errorf("Search(go) = %v, want none", resultKeys(results))
+	}
+	if results := index.Search("  ,.  ", 0); len(results) != 0 {
+		t.Errorf("Search without term = %v, want none", resultKeys(results))
+	}
+}
+
+func TestInvertedIndexUpdate(t *testing.T) {
+	index := newTestIndex()
+
+	index.Add(Document{Type: "project", ID: 2, Title: "Blog", Fields: []Field{
+		{Name: "name", Text: "Blog", Weight: 3},
+		{Name: "description", Text: "Personal blog about travel", Weight: 1},
+	}})
+
+	if got := resultKeys(index.Search("golang", 0)); len(got) != 2 || got[0] != (documentKey{"skill", 1}) || got[1] != (documentKey{"project", 1}) {
+		t.Errorf("Search(golang) after update = %v, want skill 1 and project 1", got)
+	}
+	if got := resultKeys(index.Search("travel", 0)); len(got) != 1 || got[0] != (documentKey{"project", 2}) {
+		t.Errorf("Search(travel) after update = %v, want project 2", got)
+	}
+	if got := resultKeys(index.Search("blog", 0)); len(got) != 1 {
+		t.Errorf("Search(blog) after update = %v, want one document", got)
+	}
+	if len(index.documents) != 3 {
+		t.Errorf("index has %d documents, want 3", len(index.documents))
+	}
+}
+
+func TestInvertedIndexRemove(t *testing.T) {
+	index := newTestIndex()
+
+	index.Remove("skill", 1)
+	// same id of other type is not removed
+	index.Remove("skill", 2)
+
+	if got := resultKeys(index.Search("golang", 0)); len(got) != 2 || got[0].Type != "project" || got[1].Type != "project" {
+		t.Errorf("Search(golang) after remove = %v, want projects only", got)
+	}
+
+	index.Remove("project", 1)
+	index.Remove("project", 2)
+	if got := index.Search("golang", 0); len(got) != 0 {
+		t.Errorf("Search(golang) on empty index = %v, want none", resultKeys(got))
+	}
+	if len(index.postings) != 0 || len(index.documents) != 0 || len(index.documentKeys) != 0 {
+		t.Errorf("index is not empty: %d postings, %d documents", len(index.postings), len(index.documents))
+	}
+}
@@ -0,0 +1,13 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// lower case words of letter and digit, "Go-lang, REST" become [go lang rest]
+func Tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,22 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := map[string][]string{
+		"Go-lang, REST":           {"go", "lang", "rest"},
+		"  HTTP/2 API v1.20 ":     {"http", "2", "api", "v1", "20"},
+		"Pembayaran Digital Café": {"pembayaran", "digital", "café"},
+		"日本語 テキスト":                {"日本語", "テキスト"},
+		"!!! --- ...":             nil,
+		"":                        nil,
+	}
+	for text, want := range tests {
+		if got := Tokenize(text); !reflect.DeepEqual(got, want) && !(len(got) == 0 && len(want) == 0) {
+			t.Errorf("Tokenize(%q) = %v, want %v", text, got, want)
+		}
+	}
+}
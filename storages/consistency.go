@@ -0,0 +1,52 @@
+package storages
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// name given by UploadImage, <content hash>_<random suffix>.<ext> or <content hash>_<random suffix>/<variant>.<ext>
+var uploadedNamePattern = regexp.MustCompile(`^[0-9a-f]{32}_[0-9a-f]{8}(/(thumbnail|card|full))?\.(jpg|png|webp|gif)$`)
+
+// key written by UploadImage under one of the directories, other file like README is never an upload
+func IsUploadedKey(key string, directories []string) bool {
+	for _, directory := range directories {
+		if name, ok := strings.CutPrefix(key, directory+"/"); ok && uploadedNamePattern.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// uploaded file under directories which no reference point to, sorted by key
+func FindOrphans(storedFiles []StoredFile, referencedKeys map[string]bool, directories []string) []StoredFile {
+	orphans := []StoredFile{}
+	for _, storedFile := range storedFiles {
+		if !referencedKeys[storedFile.Key] && IsUploadedKey(storedFile.Key, directories) {
+			orphans = append(orphans, storedFile)
+		}
+	}
+	slices.SortFunc(orphans, func(a, b StoredFile) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return orphans
+}
+
+// delete orphan modified before deleteBefore, younger one may belong to a row which is still being inserted
+func DeleteOrphans(storage Storage, orphans []StoredFile, deleteBefore time.Time) (deleted []string, skipped []string, failed []string) {
+	deleted, skipped, failed = []string{}, []string{}, []string{}
+	for _, orphan := range orphans {
+		if orphan.ModifiedAt.After(deleteBefore) {
+			skipped = append(skipped, orphan.Key)
+			continue
+		}
+		if err := storage.Delete(orphan.Key); err != nil {
+			failed = append(failed, orphan.Key)
+			continue
+		}
+		deleted = append(deleted, orphan.Key)
+	}
+	return deleted, skipped, failed
+}
@@ -0,0 +1,83 @@
+package storages
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsUploadedKey(t *testing.T) {
+	directories := []string{"images/company", "images/user/project/showcase"}
+	tests := []struct {
+		key  string
+		want bool
+	}{
+		{"images/company/0123456789abcdef0123456789abcdef_0a1b2c3d.webp", true},
+		{"images/company/0123456789abcdef0123456789abcdef_0a1b2c3d/full.jpg", true},
+		{"images/company/0123456789abcdef0123456789abcdef_0a1b2c3d/thumbnail.png", true},
+		{"images/user/project/showcase/0123456789abcdef0123456789abcdef_0a1b2c3d/card.jpg", true},
+		{"images/company/README.md", false},
+		{"images/company/ABC.png", false},
+		{"images/company/0123456789abcdef0123456789abcdef_0a1b2c3d.svg", false},
+		{"images/company/0123456789abcdef0123456789abcdef_0a1b2c3d/other.jpg", false},
+		{"images/docs/0123456789abcdef0123456789abcdef_0a1b2c3d.png", false},
+		{"images/user/project/showcase_attachment/0123456789abcdef0123456789abcdef_0a1b2c3d.png", false},
+	}
+	for _, test := range tests {
+		if got := IsUploadedKey(test.key, directories); got != test.want {
+			t.Errorf("IsUploadedKey(%q) = %v, want %v", test.key, got, test.want)
+		}
+	}
+}
+
+func TestDeleteOrphansKeepReadme(t *testing.T) {
+	directory := t.TempDir()
+	storage := NewLocalStorage(directory, "")
+
+	orphanKey := "images/company/0123456789abcdef0123456789abcdef_0a1b2c3d/full.png"
+	referencedKey := "images/company/fedcba9876543210fedcba9876543210_0a1b2c3d/full.png"
+	recentKey := "images/company/00000000000000000000000000000000_00000000.webp"
+	readmeKey := "images/company/README.md"
+	for _, key := range []string{orphanKey, referencedKey, recentKey, readmeKey} {
+		if err := storage.Put(key, strings.NewReader("content"), ""); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	old := time.Now().Add(-2 * time.Hour)
+	for _, key := range []string{orphanKey, referencedKey, readmeKey} {
+		if err := os.Chtimes(filepath.Join(directory, key), old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	storedFiles, err := storage.List("images/")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orphans := FindOrphans(storedFiles, map[string]bool{referencedKey: true}, []string{"images/company"})
+	if len(orphans) != 2 || orphans[0].Key != recentKey || orphans[1].Key != orphanKey {
+		t.Fatalf("orphans = %v, want %s and %s", orphans, recentKey, orphanKey)
+	}
+
+	deleted, skipped, failed := DeleteOrphans(storage, orphans, time.Now().Add(-time.Hour))
+	if len(deleted) != 1 || deleted[0] != orphanKey {
+		t.Errorf("deleted = %v, want %s", deleted, orphanKey)
+	}
+	if len(skipped) != 1 || skipped[0] != recentKey {
+		t.Errorf("skipped = %v, want %s", skipped, recentKey)
+	}
+	if len(failed) != 0 {
+		t.Errorf("failed = %v, want none", failed)
+	}
+
+	for key, want := range map[string]bool{orphanKey: false, referencedKey: true, recentKey: true, readmeKey: true} {
+		_, err := os.Stat(filepath.Join(directory, key))
+		if exists := err == nil; exists != want {
+			t.Errorf("%s exists = %v, want %v", key, exists, want)
+		}
+	}
+}
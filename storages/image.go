@@ -0,0 +1,140 @@
+package storages
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"path"
+	"strings"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+var ErrInvalidImage = errors.New("image can not be decoded or has too many pixels")
+
+// decoding bigger image take too much memory, a 10 MB upload is rarely more than a 24 megapixel photo
+const maxImagePixels = 24_000_000
+
+const jpegQuality = 85
+
+type ImageVariant struct {
+	Name string
+	// longest side in pixel, smaller image is never enlarged
+	MaxSize int
+}
+
+// processed image is stored as <directory>/<name>/<variant>.<ext>, image url in database point to the full variant
+var ImageVariants = []ImageVariant{
+	{Name: "thumbnail", MaxSize: 200},
+	{Name: "card", MaxSize: 600},
+	{Name: "full", MaxSize: 1600},
+}
+
+// webp has no decoder in standard library, it is stored as uploaded with metadata stripped
+func canProcessImage(contentType string) bool {
+	return contentType == "image/jpeg" || contentType == "image/png" || contentType == "image/gif"
+}
+
+// decode image, apply exif orientation and store every variant re-encoded without metadata.
+// gif is stored from its first frame as png
+func (uploader *Uploader) putImageVariants(base string, content []byte) (string, error) {
+	imageConfig, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || imageConfig.Width*imageConfig.Height > maxImagePixels {
+		return "", ErrInvalidImage
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return "", ErrInvalidImage
+	}
+	// converted once, every variant is resized from the same pixels
+	rgba := toRGBA(img)
+	if format == "jpeg" {
+		rgba = applyOrientation(rgba, jpegOrientation(content))
+	}
+
+	extension, contentType := ".png", "image/png"
+	if format == "jpeg" {
+		extension, contentType = ".jpg", "image/jpeg"
+	}
+
+	var storedKeys []string
+	for _, variant := range ImageVariants {
+		var buffer bytes.Buffer
+		resized := resizeToFit(rgba, variant.MaxSize)
+		if format == "jpeg" {
+			err = jpeg.Encode(&buffer, resized, &jpeg.Options{Quality: jpegQuality})
+		} else {
+			err = png.Encode(&buffer, resized)
+		}
+
+		key := base + "/" + variant.Name + extension
+		if err == nil {
+			err = uploader.Storage.Put(key, &buffer, contentType)
+		}
+		if err != nil {
+			// do not leave half of the variants behind
+			for _, storedKey := range storedKeys {
+				uploader.Storage.Delete(storedKey)
+			}
+			return "", err
+		}
+		storedKeys = append(storedKeys, key)
+	}
+
+	return base + "/full" + extension, nil
+}
+
+// key of every variant by name, image stored before processing existed only has full
+func ImageVariantKeys(key string) map[string]string {
+	directory, filename := path.Split(key)
+	extension := path.Ext(filename)
+	if strings.TrimSuffix(filename, extension) != "full" {
+		return map[string]string{"full": key}
+	}
+
+	variantKeys := map[string]string{}
+	for _, variant := range ImageVariants {
+		variantKeys[variant.Name] = directory + variant.Name + extension
+	}
+	return variantKeys
+}
+
+// srcset style map of variant name to public url, nil for empty and absolute url only has full
+func ImageSrcset(storage Storage, path string) map[string]string {
+	if path == "" {
+		return nil
+	}
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return map[string]string{"full": path}
+	}
+
+	srcset := map[string]string{}
+	for name, key := range ImageVariantKeys(KeyFromPath(path)) {
+		srcset[name] = storage.URL(key)
+	}
+	return srcset
+}
+
+// srcset with storage from configuration
+func GetImageSrcset(path string) map[string]string {
+	return ImageSrcset(NewStorage(config.GetStorageConfig()), path)
+}
+
+// delete image with every variant, absolute url is not ours to delete
+func DeleteImage(storage Storage, path string) error {
+	if path == "" || strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return nil
+	}
+
+	var deleteErr error
+	for _, key := range ImageVariantKeys(KeyFromPath(path)) {
+		if err := storage.Delete(key); err != nil {
+			deleteErr = err
+		}
+	}
+	return deleteErr
+}
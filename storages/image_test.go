@@ -0,0 +1,62 @@
+package storages
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func webPChunk(fourCC string, payload []byte) []byte {
+	chunk := append([]byte(fourCC), 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(chunk[4:], uint32(len(payload)))
+	chunk = append(chunk, payload...)
+	if len(payload)%2 == 1 {
+		chunk = append(chunk, 0)
+	}
+	return chunk
+}
+
+func webPFile(chunks ...[]byte) []byte {
+	content := []byte("RIFF\x00\x00\x00\x00WEBP")
+	for _, chunk := range chunks {
+		content = append(content, chunk...)
+	}
+	binary.LittleEndian.PutUint32(content[4:], uint32(len(content)-8))
+	return content
+}
+
+func TestStripWebPMetadata(t *testing.T) {
+	// icc, alpha, exif and xmp flag set
+	vp8x := []byte{0x20 | 0x10 | 0x08 | 0x04, 0, 0, 0, 9, 0, 0, 9, 0, 0}
+	imageData := []byte("image data")
+	content := webPFile(
+		webPChunk("VP8X", vp8x),
+		webPChunk("VP8L", imageData),
+		webPChunk("EXIF", []byte("Exif\x00\x00GPS")),
+		webPChunk("XMP ", []byte("<x:xmpmeta/>")),
+	)
+
+	stripped, err := stripWebPMetadata(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantVP8X := append([]byte{0x20 | 0x10}, vp8x[1:]...)
+	want := webPFile(webPChunk("VP8X", wantVP8X), webPChunk("VP8L", imageData))
+	if !bytes.Equal(stripped, want) {
+		t.Errorf("stripped = %q, want %q", stripped, want)
+	}
+}
+
+func TestStripWebPMetadataInvalid(t *testing.T) {
+	tests := map[string][]byte{
+		"not riff":         []byte("RIFX\x00\x00\x00\x00WEBP"),
+		"truncated header": webPFile([]byte("VP8")),
+		"chunk too long":   webPFile([]byte("VP8L\xff\x00\x00\x00data")),
+	}
+	for name, content := range tests {
+		if _, err := stripWebPMetadata(content); err != ErrInvalidImage {
+			t.Errorf("%s: err = %v, want %v", name, err, ErrInvalidImage)
+		}
+	}
+}
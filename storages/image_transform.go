@@ -0,0 +1,191 @@
+package storages
+
+import (
+	"encoding/binary"
+	"image"
+	"image/draw"
+)
+
+// downscale with box filter so longest side fit maxSize, every source pixel contribute to the result
+func resizeToFit(rgba *image.RGBA, maxSize int) *image.RGBA {
+	srcWidth, srcHeight := rgba.Rect.Dx(), rgba.Rect.Dy()
+	if srcWidth <= maxSize && srcHeight <= maxSize {
+		return rgba
+	}
+
+	dstWidth, dstHeight := maxSize, maxSize
+	if srcWidth >= srcHeight {
+		dstHeight = max(1, srcHeight*maxSize/srcWidth)
+	} else {
+		dstWidth = max(1, srcWidth*maxSize/srcHeight)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for dy := 0; dy < dstHeight; dy++ {
+		y0, y1 := dy*srcHeight/dstHeight, max((dy+1)*srcHeight/dstHeight, dy*srcHeight/dstHeight+1)
+		for dx := 0; dx < dstWidth; dx++ {
+			x0, x1 := dx*srcWidth/dstWidth, max((dx+1)*srcWidth/dstWidth, dx*srcWidth/dstWidth+1)
+
+			var r, g, b, a, count uint64
+			for y := y0; y < y1; y++ {
+				offset := y*rgba.Stride + x0*4
+				for x := x0; x < x1; x++ {
+					r += uint64(rgba.Pix[offset])
+					g += uint64(rgba.Pix[offset+1])
+					b += uint64(rgba.Pix[offset+2])
+					a += uint64(rgba.Pix[offset+3])
+					offset += 4
+					count++
+				}
+			}
+
+			offset := dy*dst.Stride + dx*4
+			dst.Pix[offset] = uint8(r / count)
+			dst.Pix[offset+1] = uint8(g / count)
+			dst.Pix[offset+2] = uint8(b / count)
+			dst.Pix[offset+3] = uint8(a / count)
+		}
+	}
+	return dst
+}
+
+// copy to rgba with origin at 0,0
+func toRGBA(src image.Image) *image.RGBA {
+	bounds := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Rect, src, bounds.Min, draw.Src)
+	return rgba
+}
+
+// rotate or flip image by exif orientation 1-8, pixels are stored upright since metadata is stripped
+func applyOrientation(rgba *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return rgba
+	}
+
+	width, height := rgba.Rect.Dx(), rgba.Rect.Dy()
+	dstWidth, dstHeight := width, height
+	if orientation >= 5 {
+		dstWidth, dstHeight = height, width
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstWidth, dstHeight))
+	for y := 0; y < dstHeight; y++ {
+		for x := 0; x < dstWidth; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2:
+				sx, sy = width-1-x, y
+			case 3:
+				sx, sy = width-1-x, height-1-y
+			case 4:
+				sx, sy = x, height-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, height-1-x
+			case 7:
+				sx, sy = width-1-y, height-1-x
+			case 8:
+				sx, sy = width-1-y, x
+			}
+			copy(dst.Pix[y*dst.Stride+x*4:y*dst.Stride+x*4+4], rgba.Pix[sy*rgba.Stride+sx*4:sy*rgba.Stride+sx*4+4])
+		}
+	}
+	return dst
+}
+
+// orientation tag of exif in jpeg app1 segment, 1 when not found
+func jpegOrientation(content []byte) int {
+	if len(content) < 4 || content[0] != 0xFF || content[1] != 0xD8 {
+		return 1
+	}
+
+	for i := 2; i+4 <= len(content) && content[i] == 0xFF; {
+		marker := content[i+1]
+		length := int(binary.BigEndian.Uint16(content[i+2:]))
+		// image data start after start of scan
+		if marker == 0xDA || length < 2 || i+2+length > len(content) {
+			return 1
+		}
+
+		segment := content[i+4 : i+2+length]
+		if marker == 0xE1 && len(segment) > 6 && string(segment[:6]) == "Exif\x00\x00" {
+			return exifOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// read orientation from first ifd of tiff header
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var byteOrder binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		byteOrder = binary.LittleEndian
+	case "MM":
+		byteOrder = binary.BigEndian
+	default:
+		return 1
+	}
+
+	offset := int(byteOrder.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+
+	entryCount := int(byteOrder.Uint16(tiff[offset:]))
+	for i := 0; i < entryCount; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if byteOrder.Uint16(tiff[entry:]) == 0x0112 {
+			return int(byteOrder.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 1
+}
+
+// remove exif and xmp chunk from webp and clear their flag in vp8x header, image data is kept as is
+func stripWebPMetadata(content []byte) ([]byte, error) {
+	if len(content) < 12 || string(content[:4]) != "RIFF" || string(content[8:12]) != "WEBP" {
+		return nil, ErrInvalidImage
+	}
+
+	stripped := append([]byte{}, content[:12]...)
+	for i := 12; i < len(content); {
+		if i+8 > len(content) {
+			return nil, ErrInvalidImage
+		}
+		fourCC := string(content[i : i+4])
+		size := int(binary.LittleEndian.Uint32(content[i+4:]))
+		// chunk payload is padded to even size
+		end := i + 8 + size + size%2
+		if size < 0 || end > len(content) {
+			return nil, ErrInvalidImage
+		}
+
+		switch fourCC {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			if size < 1 {
+				return nil, ErrInvalidImage
+			}
+			chunkStart := len(stripped)
+			stripped = append(stripped, content[i:end]...)
+			stripped[chunkStart+8] &^= 0x08 | 0x04
+		default:
+			stripped = append(stripped, content[i:end]...)
+		}
+		i = end
+	}
+
+	binary.LittleEndian.PutUint32(stripped[4:], uint32(len(stripped)-8))
+	return stripped, nil
+}
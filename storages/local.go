@@ -0,0 +1,120 @@
+package storages
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// local disk, directory is served by the api under /assets/
+type LocalStorage struct {
+	Directory string
+	// without public url the file url is relative to the api host
+	PublicURL string
+}
+
+func NewLocalStorage(directory string, publicURL string) *LocalStorage {
+	return &LocalStorage{
+		Directory: directory,
+		PublicURL: strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+func (storage *LocalStorage) Put(key string, content io.Reader, contentType string) error {
+	filePath, err := storage.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %v", err)
+	}
+
+	f, err := os.Create(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to create file: %v", err)
+	}
+
+	if _, err := io.Copy(f, content); err != nil {
+		f.Close()
+		os.Remove(filePath)
+		return fmt.Errorf("failed to copy file: %v", err)
+	}
+
+	return f.Close()
+}
+
+// deleting missing file is not an error
+func (storage *LocalStorage) Delete(key string) error {
+	filePath, err := storage.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(filePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove file: %v", err)
+	}
+
+	// directory of image variants is left empty, removing non empty directory fails and is ignored
+	os.Remove(filepath.Dir(filePath))
+
+	return nil
+}
+
+// missing directory has no file
+func (storage *LocalStorage) List(prefix string) ([]StoredFile, error) {
+	var storedFiles []StoredFile
+	root := filepath.Join(storage.Directory, filepath.FromSlash(prefix))
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		key, err := filepath.Rel(storage.Directory, filePath)
+		if err != nil {
+			return err
+		}
+
+		storedFiles = append(storedFiles, StoredFile{
+			Key:        filepath.ToSlash(key),
+			Size:       info.Size(),
+			ModifiedAt: info.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files: %v", err)
+	}
+
+	return storedFiles, nil
+}
+
+func (storage *LocalStorage) URL(key string) string {
+	if storage.PublicURL == "" {
+		return "/assets/" + key
+	}
+	return storage.PublicURL + "/" + key
+}
+
+// key must stay inside the storage directory
+func (storage *LocalStorage) path(key string) (string, error) {
+	cleanKey := filepath.Clean("/" + key)
+	if cleanKey == "/" {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(storage.Directory, cleanKey), nil
+}
@@ -0,0 +1,236 @@
+package storages
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// s3 compatible object storage with path style url, <endpoint>/<bucket>/<key>, signed with aws signature v4
+type S3Storage struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// cdn or bucket website url, default to <endpoint>/<bucket>
+	PublicURL string
+	Client    *http.Client
+}
+
+func NewS3Storage(endpoint string, region string, bucket string, accessKey string, secretKey string, publicURL string, timeout time.Duration) *S3Storage {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	publicURL = strings.TrimSuffix(publicURL, "/")
+	if publicURL == "" {
+		publicURL = endpoint + "/" + bucket
+	}
+
+	return &S3Storage{
+		Endpoint:  endpoint,
+		Region:    region,
+		Bucket:    bucket,
+		AccessKey: accessKey,
+		SecretKey: secretKey,
+		PublicURL: publicURL,
+		Client:    &http.Client{Timeout: timeout},
+	}
+}
+
+func (storage *S3Storage) Put(key string, content io.Reader, contentType string) error {
+	body, err := io.ReadAll(content)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPut, storage.objectURL(key), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	return storage.do(req, body)
+}
+
+// s3 answers 204 for missing object too
+func (storage *S3Storage) Delete(key string) error {
+	req, err := http.NewRequest(http.MethodDelete, storage.objectURL(key), nil)
+	if err != nil {
+		return err
+	}
+
+	return storage.do(req, nil)
+}
+
+type s3ListResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// ListObjectsV2, follow continuation token until every page is read
+func (storage *S3Storage) List(prefix string) ([]StoredFile, error) {
+	var storedFiles []StoredFile
+	continuationToken := ""
+	for {
+		query := map[string]string{"list-type": "2", "prefix": prefix}
+		if continuationToken != "" {
+			query["continuation-token"] = continuationToken
+		}
+
+		req, err := http.NewRequest(http.MethodGet, storage.Endpoint+"/"+encodeS3Path(storage.Bucket)+"?"+canonicalS3Query(query), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result s3ListResult
+		if err := storage.doDecode(req, nil, &result); err != nil {
+			return nil, err
+		}
+
+		for _, content := range result.Contents {
+			storedFiles = append(storedFiles, StoredFile{
+				Key:        content.Key,
+				Size:       content.Size,
+				ModifiedAt: content.LastModified,
+			})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return storedFiles, nil
+		}
+		continuationToken = result.NextContinuationToken
+	}
+}
+
+func (storage *S3Storage) URL(key string) string {
+	return storage.PublicURL + "/" + encodeS3Path(key)
+}
+
+func (storage *S3Storage) objectURL(key string) string {
+	return storage.Endpoint + "/" + encodeS3Path(storage.Bucket) + "/" + encodeS3Path(key)
+}
+
+func (storage *S3Storage) do(req *http.Request, body []byte) error {
+	return storage.doDecode(req, body, nil)
+}
+
+// send signed request, xml body of successful response is decoded into result when not nil
+func (storage *S3Storage) doDecode(req *http.Request, body []byte, result interface{}) error {
+	storage.sign(req, body, time.Now())
+
+	resp, err := storage.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 %s %s failed with status %d: %s", req.Method, req.URL.Path, resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	if result != nil {
+		if err := xml.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("s3 %s %s: invalid response: %v", req.Method, req.URL.Path, err)
+		}
+	}
+	return nil
+}
+
+// aws signature version 4 on host, payload hash and date header
+func (storage *S3Storage) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + payloadHash + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + storage.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+storage.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, storage.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", storage.AccessKey, scope, signedHeaders, signature))
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// query sorted by name and encoded the same way as the signature expect
+func canonicalS3Query(query map[string]string) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parameters := make([]string, 0, len(names))
+	for _, name := range names {
+		parameters = append(parameters, encodeS3(name, false)+"="+encodeS3(query[name], false))
+	}
+	return strings.Join(parameters, "&")
+}
+
+func encodeS3Path(path string) string {
+	return encodeS3(path, true)
+}
+
+// uri encode every byte except unreserved character, slash is kept only in path
+func encodeS3(value string, keepSlash bool) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') || c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && keepSlash) {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,58 @@
+package storages
+
+import (
+	"io"
+	"strings"
+	"time"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+// store uploaded file by key like images/company/ABC.png, implementation can be local disk, s3 compatible, etc
+type Storage interface {
+	Put(key string, content io.Reader, contentType string) error
+	Delete(key string) error
+	URL(key string) string
+	// every stored file with key starting with prefix
+	List(prefix string) ([]StoredFile, error)
+}
+
+type StoredFile struct {
+	Key        string    `json:"key"`
+	Size       int64     `json:"size"`
+	ModifiedAt time.Time `json:"modified_at"`
+}
+
+// choose storage from configuration, default to local disk
+func NewStorage(storageConfig config.StorageConfig) Storage {
+	switch storageConfig.Driver {
+	case "s3":
+		return NewS3Storage(storageConfig.S3Endpoint, storageConfig.S3Region, storageConfig.S3Bucket, storageConfig.S3AccessKey, storageConfig.S3SecretKey, storageConfig.PublicURL, storageConfig.Timeout)
+	default:
+		return NewLocalStorage(storageConfig.LocalDirectory, storageConfig.PublicURL)
+	}
+}
+
+// key of stored file, older rows still keep local path like ./assets/images/company/ABC.png
+func KeyFromPath(path string) string {
+	key := strings.TrimPrefix(path, "./")
+	key = strings.TrimPrefix(key, "/")
+	key = strings.TrimPrefix(key, "assets/")
+	return key
+}
+
+// public url of stored file, empty stays empty and absolute url is kept as is
+func PublicURL(storage Storage, path string) string {
+	if path == "" {
+		return ""
+	}
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	return storage.URL(KeyFromPath(path))
+}
+
+// public url with storage from configuration
+func GetPublicURL(path string) string {
+	return PublicURL(NewStorage(config.GetStorageConfig()), path)
+}
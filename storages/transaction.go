@@ -0,0 +1,66 @@
+package storages
+
+import (
+	"errors"
+	"io"
+)
+
+// storage whose file operations follow a database transaction. put is written right away so the row can
+// point to it and is removed again on rollback, delete is postponed to commit so rollback keep the old file
+type FileTransaction struct {
+	Storage Storage
+	putKeys []string
+	// staged until commit
+	deleteKeys []string
+}
+
+func NewFileTransaction(storage Storage) *FileTransaction {
+	return &FileTransaction{Storage: storage}
+}
+
+func (fileTransaction *FileTransaction) Put(key string, content io.Reader, contentType string) error {
+	if err := fileTransaction.Storage.Put(key, content, contentType); err != nil {
+		return err
+	}
+	fileTransaction.putKeys = append(fileTransaction.putKeys, key)
+	return nil
+}
+
+func (fileTransaction *FileTransaction) Delete(key string) error {
+	fileTransaction.deleteKeys = append(fileTransaction.deleteKeys, key)
+	return nil
+}
+
+func (fileTransaction *FileTransaction) URL(key string) string {
+	return fileTransaction.Storage.URL(key)
+}
+
+func (fileTransaction *FileTransaction) List(prefix string) ([]StoredFile, error) {
+	return fileTransaction.Storage.List(prefix)
+}
+
+// apply staged delete, every file is tried even when one fails
+func (fileTransaction *FileTransaction) Commit() error {
+	var errs []error
+	for _, key := range fileTransaction.deleteKeys {
+		if err := fileTransaction.Storage.Delete(key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	fileTransaction.putKeys = nil
+	fileTransaction.deleteKeys = nil
+	return errors.Join(errs...)
+}
+
+// remove written file and forget staged delete
+func (fileTransaction *FileTransaction) Rollback() error {
+	var errs []error
+	for _, key := range fileTransaction.putKeys {
+		if err := fileTransaction.Storage.Delete(key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	fileTransaction.putKeys = nil
+	fileTransaction.deleteKeys = nil
+	return errors.Join(errs...)
+}
@@ -0,0 +1,110 @@
+package storages
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+var ErrFileTypeNotAllowed = errors.New("file type is not allowed")
+
+type FileTooLargeError struct {
+	ContentType string
+	MaxSize     int64
+}
+
+func (err *FileTooLargeError) Error() string {
+	return fmt.Sprintf("%s file is larger than %d bytes", err.ContentType, err.MaxSize)
+}
+
+// extension is chosen from the sniffed type, never from client filename
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+	"image/gif":  ".gif",
+}
+
+// check uploaded file before it reach the storage
+type Uploader struct {
+	Storage       Storage
+	MaxImageSizes map[string]int64
+}
+
+func NewUploader(storage Storage, uploadConfig config.UploadConfig) *Uploader {
+	return &Uploader{
+		Storage:       storage,
+		MaxImageSizes: uploadConfig.MaxImageSizes,
+	}
+}
+
+// allowed image type, sorted for message
+func (uploader *Uploader) AllowedImageTypes() []string {
+	var contentTypes []string
+	for contentType := range uploader.MaxImageSizes {
+		if _, ok := imageExtensions[contentType]; ok {
+			contentTypes = append(contentTypes, contentType)
+		}
+	}
+	sort.Strings(contentTypes)
+	return contentTypes
+}
+
+// store image under directory and return its key. content type is sniffed from the content,
+// name is content hash with random suffix so same image uploaded twice never share a key.
+// jpeg, png and gif are stored as size variants, see ImageVariants, webp is stored without exif and xmp
+func (uploader *Uploader) UploadImage(directory string, file io.Reader) (string, error) {
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", fmt.Errorf("failed to read file: %v", err)
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	extension, ok := imageExtensions[contentType]
+	maxSize, allowed := uploader.MaxImageSizes[contentType]
+	if !ok || !allowed {
+		return "", ErrFileTypeNotAllowed
+	}
+
+	content, err := io.ReadAll(io.LimitReader(io.MultiReader(bytes.NewReader(head), file), maxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %v", err)
+	}
+	if int64(len(content)) > maxSize {
+		return "", &FileTooLargeError{ContentType: contentType, MaxSize: maxSize}
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(content)
+	name := path.Join(directory, hex.EncodeToString(hash[:16])+"_"+hex.EncodeToString(suffix))
+	if canProcessImage(contentType) {
+		return uploader.putImageVariants(name, content)
+	}
+
+	if contentType == "image/webp" {
+		if content, err = stripWebPMetadata(content); err != nil {
+			return "", err
+		}
+	}
+
+	key := name + extension
+	if err := uploader.Storage.Put(key, bytes.NewReader(content), contentType); err != nil {
+		return "", fmt.Errorf("failed to store file: %v", err)
+	}
+
+	return key, nil
+}
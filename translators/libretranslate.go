@@ -0,0 +1,73 @@
+package translators
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// LibreTranslate compatible api, POST <url>/translate
+type LibreTranslateTranslator struct {
+	URL    string
+	APIKey string
+	Client *http.Client
+}
+
+type libreTranslateRequest struct {
+	Q      string `json:"q"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Format string `json:"format"`
+	APIKey string `json:"api_key,omitempty"`
+}
+
+type libreTranslateResponse struct {
+	TranslatedText string `json:"translatedText"`
+	Error          string `json:"error"`
+}
+
+func NewLibreTranslateTranslator(url string, apiKey string, timeout time.Duration) *LibreTranslateTranslator {
+	return &LibreTranslateTranslator{
+		URL:    strings.TrimSuffix(url, "/"),
+		APIKey: apiKey,
+		Client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (translator *LibreTranslateTranslator) Translate(text string, sourceLanguage string, targetLanguage string) (string, error) {
+	if strings.TrimSpace(text) == "" {
+		return text, nil
+	}
+
+	body, err := json.Marshal(libreTranslateRequest{
+		Q:      text,
+		Source: sourceLanguage,
+		Target: targetLanguage,
+		Format: "text",
+		APIKey: translator.APIKey,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := translator.Client.Post(translator.URL+"/translate", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result libreTranslateResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("libretranslate: invalid response with status %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		if result.Error == "" {
+			result.Error = http.StatusText(resp.StatusCode)
+		}
+		return "", fmt.Errorf("libretranslate: %s", result.Error)
+	}
+	return result.TranslatedText, nil
+}
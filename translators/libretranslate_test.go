@@ -0,0 +1,95 @@
+package translators
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLibreTranslateTranslate(t *testing.T) {
+	var received libreTranslateRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/translate" {
+			t.Errorf("request = %s %s, want POST /translate", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"translatedText":"Halo dunia"}`))
+	}))
+	defer server.Close()
+
+	translator := NewLibreTranslateTranslator(server.URL+"/", "secret", time.Second)
+	translated, err := translator.Translate("Hello world", "en", "id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if translated != "Halo dunia" {
+		t.Errorf("translated = %s, want Halo dunia", translated)
+	}
+	want := libreTranslateRequest{Q: "Hello world", Source: "en", Target: "id", Format: "text", APIKey: "secret"}
+	if received != want {
+		t.Errorf("request body = %+v, want %+v", received, want)
+	}
+}
+
+func TestLibreTranslateEmptyText(t *testing.T) {
+	translator := NewLibreTranslateTranslator("http://127.0.0.1:0", "", time.Second)
+	translated, err := translator.Translate("  ", "en", "id")
+	if err != nil || translated != "  " {
+		t.Errorf("Translate = %q, %v, want text returned without request", translated, err)
+	}
+}
+
+func TestLibreTranslateErrorStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{"error message", http.StatusBadRequest, `{"error":"id is not supported"}`, "libretranslate: id is not supported"},
+		{"no error message", http.StatusTooManyRequests, `{}`, "libretranslate: Too Many Requests"},
+		{"not json", http.StatusBadGateway, `<html>bad gateway</html>`, "libretranslate: invalid response with status 502"},
+	}
+	for _, test := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(test.status)
+			w.Write([]byte(test.body))
+		}))
+
+		translator := NewLibreTranslateTranslator(server.URL, "", time.Second)
+		translated, err := translator.Translate("Hello", "en", "id")
+		if err == nil || err.Error() != test.wantErr || translated != "" {
+			t.Errorf("%s: Translate = %q, %v, want error %s", test.name, translated, err, test.wantErr)
+		}
+		server.Close()
+	}
+}
+
+func TestLibreTranslateTimeout(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer server.Close()
+	defer close(done)
+
+	translator := NewLibreTranslateTranslator(server.URL, "", 50*time.Millisecond)
+	_, err := translator.Translate("Hello", "en", "id")
+
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Errorf("err = %v, want timeout", err)
+	}
+	if err != nil && !strings.Contains(err.Error(), "/translate") {
+		t.Errorf("err = %v, want url in message", err)
+	}
+}
@@ -0,0 +1,12 @@
+package translators
+
+// keep source text as is, draft translation is a copy waiting for human translation
+type NoopTranslator struct{}
+
+func NewNoopTranslator() *NoopTranslator {
+	return &NoopTranslator{}
+}
+
+func (translator *NoopTranslator) Translate(text string, sourceLanguage string, targetLanguage string) (string, error) {
+	return text, nil
+}
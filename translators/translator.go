@@ -0,0 +1,20 @@
+package translators
+
+import (
+	"github.com/FRanggaY/personal-portfolio-api/config"
+)
+
+// translate plain text between language codes, implementation can be libretranslate, cloud provider, etc
+type Translator interface {
+	Translate(text string, sourceLanguage string, targetLanguage string) (string, error)
+}
+
+// choose translator from configuration, default to no-op
+func NewTranslator(translatorConfig config.TranslatorConfig) Translator {
+	switch translatorConfig.Provider {
+	case "libretranslate":
+		return NewLibreTranslateTranslator(translatorConfig.URL, translatorConfig.APIKey, translatorConfig.Timeout)
+	default:
+		return NewNoopTranslator()
+	}
+}